@@ -0,0 +1,161 @@
+/*
+Copyright 2022 Upbound Inc.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PolicyDocumentCondition is a condition under which a statement applies.
+type PolicyDocumentCondition struct {
+	// Name of the condition operator, e.g. StringEquals or IpAddress.
+	// +kubebuilder:validation:Required
+	Test string `json:"test"`
+
+	// Name of the context variable to evaluate, e.g. aws:SourceIp.
+	// +kubebuilder:validation:Required
+	Variable string `json:"variable"`
+
+	// Values to compare the context variable against.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Values []string `json:"values"`
+}
+
+// PolicyDocumentStatement is a single statement of a policy document.
+type PolicyDocumentStatement struct {
+	// Statement ID. Statements with the same ID in sourceJson are replaced,
+	// and statements in overrideJson replace these.
+	// +kubebuilder:validation:Optional
+	Sid *string `json:"sid,omitempty"`
+
+	// Whether the statement allows or denies the actions.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Allow;Deny
+	// +kubebuilder:default=Allow
+	Effect *string `json:"effect,omitempty"`
+
+	// Principal the statement applies to, such as "*". Only used by bucket
+	// policies.
+	// +kubebuilder:validation:Optional
+	Principal *string `json:"principal,omitempty"`
+
+	// Actions the statement allows or denies, e.g. s3:GetObject.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Actions []string `json:"actions,omitempty"`
+
+	// Resources the statement applies to, e.g. arn:aws:s3:::bucket/*.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Resources []string `json:"resources,omitempty"`
+
+	// Conditions under which the statement applies.
+	// +kubebuilder:validation:Optional
+	Condition []PolicyDocumentCondition `json:"condition,omitempty"`
+}
+
+// PolicyDocumentParameters are the inputs used to render a policy document.
+type PolicyDocumentParameters struct {
+	// Version of the policy language.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="2012-10-17"
+	Version *string `json:"version,omitempty"`
+
+	// ID of the policy document.
+	// +kubebuilder:validation:Optional
+	PolicyID *string `json:"policyId,omitempty"`
+
+	// Policy JSON whose statements the statements of this document are
+	// merged into.
+	// +kubebuilder:validation:Optional
+	SourceJSON *string `json:"sourceJson,omitempty"`
+
+	// Policy JSON whose statements replace statements of this document with
+	// the same sid.
+	// +kubebuilder:validation:Optional
+	OverrideJSON *string `json:"overrideJson,omitempty"`
+
+	// Statements of the policy document.
+	// +kubebuilder:validation:Optional
+	Statement []PolicyDocumentStatement `json:"statement,omitempty"`
+}
+
+// PolicyDocumentObservation is the rendered policy document.
+type PolicyDocumentObservation struct {
+	// Rendered policy JSON.
+	JSON *string `json:"json,omitempty"`
+}
+
+// PolicyDocumentSpec defines the desired state of PolicyDocument
+type PolicyDocumentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PolicyDocumentParameters `json:"forProvider"`
+}
+
+// PolicyDocumentStatus defines the observed state of PolicyDocument.
+type PolicyDocumentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PolicyDocumentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// PolicyDocument renders an IAM policy document from structured statements.
+// It never creates anything in MinIO; Policy and BucketPolicy can reference
+// the rendered JSON in status.atProvider.json.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,minio}
+type PolicyDocument struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              PolicyDocumentSpec   `json:"spec"`
+	Status            PolicyDocumentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyDocumentList contains a list of PolicyDocuments
+type PolicyDocumentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PolicyDocument `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	PolicyDocument_Kind             = "PolicyDocument"
+	PolicyDocument_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: PolicyDocument_Kind}.String()
+	PolicyDocument_KindAPIVersion   = PolicyDocument_Kind + "." + CRDGroupVersion.String()
+	PolicyDocument_GroupVersionKind = CRDGroupVersion.WithKind(PolicyDocument_Kind)
+)
+
+// PolicyDocumentJSON extracts the rendered policy JSON of a referenced
+// PolicyDocument.
+func PolicyDocumentJSON() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		paved, err := fieldpath.PaveObject(mg)
+		if err != nil {
+			return ""
+		}
+		r, err := paved.GetString("status.atProvider.json")
+		if err != nil {
+			return ""
+		}
+		return r
+	}
+}
+
+func init() {
+	SchemeBuilder.Register(&PolicyDocument{}, &PolicyDocumentList{})
+}
@@ -6,9 +6,30 @@
 
 package v1alpha1
 
+// Hub marks this type as a conversion hub.
+func (tr *AccessKey) Hub() {}
+
 // Hub marks this type as a conversion hub.
 func (tr *Group) Hub() {}
 
+// Hub marks this type as a conversion hub.
+func (tr *GroupMembership) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *GroupPolicy) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *GroupPolicyAttachment) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *GroupUserAttachment) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *LDAPGroupPolicyAttachment) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *LDAPUserPolicyAttachment) Hub() {}
+
 // Hub marks this type as a conversion hub.
 func (tr *Policy) Hub() {}
 
@@ -17,3 +38,6 @@ func (tr *ServiceAccount) Hub() {}
 
 // Hub marks this type as a conversion hub.
 func (tr *User) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *UserPolicyAttachment) Hub() {}
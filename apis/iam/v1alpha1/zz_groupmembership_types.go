@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type GroupMembershipInitParameters struct {
+
+	// Group name to add users
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1.Group
+	Group *string `json:"group,omitempty" tf:"group,omitempty"`
+
+	// Reference to a Group in iam to populate group.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a Group in iam to populate group.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
+	// Add user or list of users such as a group membership
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1.User
+	// +listType=set
+	Users []*string `json:"users,omitempty" tf:"users,omitempty"`
+
+	// References to User in iam to populate users.
+	// +kubebuilder:validation:Optional
+	UsersRefs []v1.Reference `json:"usersRefs,omitempty" tf:"-"`
+
+	// Selector for a list of User in iam to populate users.
+	// +kubebuilder:validation:Optional
+	UsersSelector *v1.Selector `json:"usersSelector,omitempty" tf:"-"`
+}
+
+type GroupMembershipObservation struct {
+
+	// Group name to add users
+	Group *string `json:"group,omitempty" tf:"group,omitempty"`
+
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Add user or list of users such as a group membership
+	// +listType=set
+	Users []*string `json:"users,omitempty" tf:"users,omitempty"`
+}
+
+type GroupMembershipParameters struct {
+
+	// Group name to add users
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1.Group
+	// +kubebuilder:validation:Optional
+	Group *string `json:"group,omitempty" tf:"group,omitempty"`
+
+	// Reference to a Group in iam to populate group.
+	// +kubebuilder:validation:Optional
+	GroupRef *v1.Reference `json:"groupRef,omitempty" tf:"-"`
+
+	// Selector for a Group in iam to populate group.
+	// +kubebuilder:validation:Optional
+	GroupSelector *v1.Selector `json:"groupSelector,omitempty" tf:"-"`
+
+	// Add user or list of users such as a group membership
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1.User
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Users []*string `json:"users,omitempty" tf:"users,omitempty"`
+
+	// References to User in iam to populate users.
+	// +kubebuilder:validation:Optional
+	UsersRefs []v1.Reference `json:"usersRefs,omitempty" tf:"-"`
+
+	// Selector for a list of User in iam to populate users.
+	// +kubebuilder:validation:Optional
+	UsersSelector *v1.Selector `json:"usersSelector,omitempty" tf:"-"`
+}
+
+// GroupMembershipSpec defines the desired state of GroupMembership
+type GroupMembershipSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     GroupMembershipParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider GroupMembershipInitParameters `json:"initProvider,omitempty"`
+}
+
+// GroupMembershipStatus defines the observed state of GroupMembership.
+type GroupMembershipStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        GroupMembershipObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// GroupMembership is the Schema for the GroupMemberships API. Manages the authoritative list of users in a MinIO IAM group. Users not listed are removed from the group, including users added by GroupUserAttachment
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,minio}
+type GroupMembership struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              GroupMembershipSpec   `json:"spec"`
+	Status            GroupMembershipStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GroupMembershipList contains a list of GroupMemberships
+type GroupMembershipList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GroupMembership `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	GroupMembership_Kind             = "GroupMembership"
+	GroupMembership_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: GroupMembership_Kind}.String()
+	GroupMembership_KindAPIVersion   = GroupMembership_Kind + "." + CRDGroupVersion.String()
+	GroupMembership_GroupVersionKind = CRDGroupVersion.WithKind(GroupMembership_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&GroupMembership{}, &GroupMembershipList{})
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type GroupPolicyAttachmentInitParameters struct {
+
+	// Name of group to attach policy to
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1.Group
+	GroupName *string `json:"groupName,omitempty" tf:"group_name,omitempty"`
+
+	// Reference to a Group in iam to populate groupName.
+	// +kubebuilder:validation:Optional
+	GroupNameRef *v1.Reference `json:"groupNameRef,omitempty" tf:"-"`
+
+	// Selector for a Group in iam to populate groupName.
+	// +kubebuilder:validation:Optional
+	GroupNameSelector *v1.Selector `json:"groupNameSelector,omitempty" tf:"-"`
+
+	// Name of policy to attach to group
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1.Policy
+	PolicyName *string `json:"policyName,omitempty" tf:"policy_name,omitempty"`
+
+	// Reference to a Policy in iam to populate policyName.
+	// +kubebuilder:validation:Optional
+	PolicyNameRef *v1.Reference `json:"policyNameRef,omitempty" tf:"-"`
+
+	// Selector for a Policy in iam to populate policyName.
+	// +kubebuilder:validation:Optional
+	PolicyNameSelector *v1.Selector `json:"policyNameSelector,omitempty" tf:"-"`
+}
+
+type GroupPolicyAttachmentObservation struct {
+
+	// Name of group to attach policy to
+	GroupName *string `json:"groupName,omitempty" tf:"group_name,omitempty"`
+
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// Name of policy to attach to group
+	PolicyName *string `json:"policyName,omitempty" tf:"policy_name,omitempty"`
+}
+
+type GroupPolicyAttachmentParameters struct {
+
+	// Name of group to attach policy to
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1.Group
+	// +kubebuilder:validation:Optional
+	GroupName *string `json:"groupName,omitempty" tf:"group_name,omitempty"`
+
+	// Reference to a Group in iam to populate groupName.
+	// +kubebuilder:validation:Optional
+	GroupNameRef *v1.Reference `json:"groupNameRef,omitempty" tf:"-"`
+
+	// Selector for a Group in iam to populate groupName.
+	// +kubebuilder:validation:Optional
+	GroupNameSelector *v1.Selector `json:"groupNameSelector,omitempty" tf:"-"`
+
+	// Name of policy to attach to group
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1.Policy
+	// +kubebuilder:validation:Optional
+	PolicyName *string `json:"policyName,omitempty" tf:"policy_name,omitempty"`
+
+	// Reference to a Policy in iam to populate policyName.
+	// +kubebuilder:validation:Optional
+	PolicyNameRef *v1.Reference `json:"policyNameRef,omitempty" tf:"-"`
+
+	// Selector for a Policy in iam to populate policyName.
+	// +kubebuilder:validation:Optional
+	PolicyNameSelector *v1.Selector `json:"policyNameSelector,omitempty" tf:"-"`
+}
+
+// GroupPolicyAttachmentSpec defines the desired state of GroupPolicyAttachment
+type GroupPolicyAttachmentSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     GroupPolicyAttachmentParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider GroupPolicyAttachmentInitParameters `json:"initProvider,omitempty"`
+}
+
+// GroupPolicyAttachmentStatus defines the observed state of GroupPolicyAttachment.
+type GroupPolicyAttachmentStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        GroupPolicyAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// GroupPolicyAttachment is the Schema for the GroupPolicyAttachments API. Attaches a MinIO IAM policy to a group
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,minio}
+type GroupPolicyAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              GroupPolicyAttachmentSpec   `json:"spec"`
+	Status            GroupPolicyAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GroupPolicyAttachmentList contains a list of GroupPolicyAttachments
+type GroupPolicyAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GroupPolicyAttachment `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	GroupPolicyAttachment_Kind             = "GroupPolicyAttachment"
+	GroupPolicyAttachment_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: GroupPolicyAttachment_Kind}.String()
+	GroupPolicyAttachment_KindAPIVersion   = GroupPolicyAttachment_Kind + "." + CRDGroupVersion.String()
+	GroupPolicyAttachment_GroupVersionKind = CRDGroupVersion.WithKind(GroupPolicyAttachment_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&GroupPolicyAttachment{}, &GroupPolicyAttachmentList{})
+}
@@ -16,7 +16,19 @@ import (
 type PolicyInitParameters struct {
 
 	// Policy JSON string
+	// +crossplane:generate:reference:type=PolicyDocument
+	// +crossplane:generate:reference:extractor=github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1.PolicyDocumentJSON()
+	// +crossplane:generate:reference:refFieldName=PolicyDocumentRef
+	// +crossplane:generate:reference:selectorFieldName=PolicyDocumentSelector
 	Policy *string `json:"policy,omitempty" tf:"policy,omitempty"`
+
+	// Reference to a PolicyDocument to populate policy.
+	// +kubebuilder:validation:Optional
+	PolicyDocumentRef *v1.Reference `json:"policyDocumentRef,omitempty" tf:"-"`
+
+	// Selector for a PolicyDocument to populate policy.
+	// +kubebuilder:validation:Optional
+	PolicyDocumentSelector *v1.Selector `json:"policyDocumentSelector,omitempty" tf:"-"`
 }
 
 type PolicyObservation struct {
@@ -29,8 +41,20 @@ type PolicyObservation struct {
 type PolicyParameters struct {
 
 	// Policy JSON string
+	// +crossplane:generate:reference:type=PolicyDocument
+	// +crossplane:generate:reference:extractor=github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1.PolicyDocumentJSON()
+	// +crossplane:generate:reference:refFieldName=PolicyDocumentRef
+	// +crossplane:generate:reference:selectorFieldName=PolicyDocumentSelector
 	// +kubebuilder:validation:Optional
 	Policy *string `json:"policy,omitempty" tf:"policy,omitempty"`
+
+	// Reference to a PolicyDocument to populate policy.
+	// +kubebuilder:validation:Optional
+	PolicyDocumentRef *v1.Reference `json:"policyDocumentRef,omitempty" tf:"-"`
+
+	// Selector for a PolicyDocument to populate policy.
+	// +kubebuilder:validation:Optional
+	PolicyDocumentSelector *v1.Selector `json:"policyDocumentSelector,omitempty" tf:"-"`
 }
 
 // PolicySpec defines the desired state of Policy
@@ -69,9 +93,8 @@ type PolicyStatus struct {
 type Policy struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
-	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.policy) || (has(self.initProvider) && has(self.initProvider.policy))",message="spec.forProvider.policy is a required parameter"
-	Spec   PolicySpec   `json:"spec"`
-	Status PolicyStatus `json:"status,omitempty"`
+	Spec              PolicySpec   `json:"spec"`
+	Status            PolicyStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,305 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type LifecyclePolicyInitParameters struct {
+
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1.Bucket
+	Bucket *string `json:"bucket,omitempty" tf:"bucket,omitempty"`
+
+	// Reference to a Bucket in s3 to populate bucket.
+	// +kubebuilder:validation:Optional
+	BucketRef *v1.Reference `json:"bucketRef,omitempty" tf:"-"`
+
+	// Selector for a Bucket in s3 to populate bucket.
+	// +kubebuilder:validation:Optional
+	BucketSelector *v1.Selector `json:"bucketSelector,omitempty" tf:"-"`
+
+	Rule []RuleInitParameters `json:"rule,omitempty" tf:"rule,omitempty"`
+}
+
+type LifecyclePolicyObservation struct {
+	Bucket *string `json:"bucket,omitempty" tf:"bucket,omitempty"`
+
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	Rule []RuleObservation `json:"rule,omitempty" tf:"rule,omitempty"`
+}
+
+type LifecyclePolicyParameters struct {
+
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1.Bucket
+	// +kubebuilder:validation:Optional
+	Bucket *string `json:"bucket,omitempty" tf:"bucket,omitempty"`
+
+	// Reference to a Bucket in s3 to populate bucket.
+	// +kubebuilder:validation:Optional
+	BucketRef *v1.Reference `json:"bucketRef,omitempty" tf:"-"`
+
+	// Selector for a Bucket in s3 to populate bucket.
+	// +kubebuilder:validation:Optional
+	BucketSelector *v1.Selector `json:"bucketSelector,omitempty" tf:"-"`
+
+	// +kubebuilder:validation:Optional
+	Rule []RuleParameters `json:"rule,omitempty" tf:"rule,omitempty"`
+}
+
+type NoncurrentExpirationInitParameters struct {
+	Days *string `json:"days,omitempty" tf:"days,omitempty"`
+
+	NewerVersions *float64 `json:"newerVersions,omitempty" tf:"newer_versions,omitempty"`
+}
+
+type NoncurrentExpirationObservation struct {
+	Days *string `json:"days,omitempty" tf:"days,omitempty"`
+
+	NewerVersions *float64 `json:"newerVersions,omitempty" tf:"newer_versions,omitempty"`
+}
+
+type NoncurrentExpirationParameters struct {
+
+	// +kubebuilder:validation:Optional
+	Days *string `json:"days" tf:"days,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	NewerVersions *float64 `json:"newerVersions,omitempty" tf:"newer_versions,omitempty"`
+}
+
+type NoncurrentTransitionInitParameters struct {
+	Days *string `json:"days,omitempty" tf:"days,omitempty"`
+
+	NewerVersions *float64 `json:"newerVersions,omitempty" tf:"newer_versions,omitempty"`
+
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/ilm/v1alpha1.Tier
+	StorageClass *string `json:"storageClass,omitempty" tf:"storage_class,omitempty"`
+
+	// Reference to a Tier in ilm to populate storageClass.
+	// +kubebuilder:validation:Optional
+	StorageClassRef *v1.Reference `json:"storageClassRef,omitempty" tf:"-"`
+
+	// Selector for a Tier in ilm to populate storageClass.
+	// +kubebuilder:validation:Optional
+	StorageClassSelector *v1.Selector `json:"storageClassSelector,omitempty" tf:"-"`
+}
+
+type NoncurrentTransitionObservation struct {
+	Days *string `json:"days,omitempty" tf:"days,omitempty"`
+
+	NewerVersions *float64 `json:"newerVersions,omitempty" tf:"newer_versions,omitempty"`
+
+	StorageClass *string `json:"storageClass,omitempty" tf:"storage_class,omitempty"`
+}
+
+type NoncurrentTransitionParameters struct {
+
+	// +kubebuilder:validation:Optional
+	Days *string `json:"days" tf:"days,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	NewerVersions *float64 `json:"newerVersions,omitempty" tf:"newer_versions,omitempty"`
+
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/ilm/v1alpha1.Tier
+	// +kubebuilder:validation:Optional
+	StorageClass *string `json:"storageClass,omitempty" tf:"storage_class,omitempty"`
+
+	// Reference to a Tier in ilm to populate storageClass.
+	// +kubebuilder:validation:Optional
+	StorageClassRef *v1.Reference `json:"storageClassRef,omitempty" tf:"-"`
+
+	// Selector for a Tier in ilm to populate storageClass.
+	// +kubebuilder:validation:Optional
+	StorageClassSelector *v1.Selector `json:"storageClassSelector,omitempty" tf:"-"`
+}
+
+type RuleInitParameters struct {
+
+	// Value may be duration (5d), date (1970-01-01), or "DeleteMarker" to expire delete markers if `noncurrent_version_expiration_days` is used
+	Expiration *string `json:"expiration,omitempty" tf:"expiration,omitempty"`
+
+	Filter *string `json:"filter,omitempty" tf:"filter,omitempty"`
+
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	NoncurrentExpiration []NoncurrentExpirationInitParameters `json:"noncurrentExpiration,omitempty" tf:"noncurrent_expiration,omitempty"`
+
+	NoncurrentTransition []NoncurrentTransitionInitParameters `json:"noncurrentTransition,omitempty" tf:"noncurrent_transition,omitempty"`
+
+	// Status of the rule. Can be either 'Enabled' or 'Disabled'. Defaults to 'Enabled'.
+	Status *string `json:"status,omitempty" tf:"status,omitempty"`
+
+	// +mapType=granular
+	Tags map[string]*string `json:"tags,omitempty" tf:"tags,omitempty"`
+
+	Transition []TransitionInitParameters `json:"transition,omitempty" tf:"transition,omitempty"`
+}
+
+type RuleObservation struct {
+
+	// Value may be duration (5d), date (1970-01-01), or "DeleteMarker" to expire delete markers if `noncurrent_version_expiration_days` is used
+	Expiration *string `json:"expiration,omitempty" tf:"expiration,omitempty"`
+
+	Filter *string `json:"filter,omitempty" tf:"filter,omitempty"`
+
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	NoncurrentExpiration []NoncurrentExpirationObservation `json:"noncurrentExpiration,omitempty" tf:"noncurrent_expiration,omitempty"`
+
+	NoncurrentTransition []NoncurrentTransitionObservation `json:"noncurrentTransition,omitempty" tf:"noncurrent_transition,omitempty"`
+
+	// Status of the rule. Can be either 'Enabled' or 'Disabled'. Defaults to 'Enabled'.
+	Status *string `json:"status,omitempty" tf:"status,omitempty"`
+
+	// +mapType=granular
+	Tags map[string]*string `json:"tags,omitempty" tf:"tags,omitempty"`
+
+	Transition []TransitionObservation `json:"transition,omitempty" tf:"transition,omitempty"`
+}
+
+type RuleParameters struct {
+
+	// Value may be duration (5d), date (1970-01-01), or "DeleteMarker" to expire delete markers if `noncurrent_version_expiration_days` is used
+	// +kubebuilder:validation:Optional
+	Expiration *string `json:"expiration,omitempty" tf:"expiration,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Filter *string `json:"filter,omitempty" tf:"filter,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	ID *string `json:"id" tf:"id,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	NoncurrentExpiration []NoncurrentExpirationParameters `json:"noncurrentExpiration,omitempty" tf:"noncurrent_expiration,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	NoncurrentTransition []NoncurrentTransitionParameters `json:"noncurrentTransition,omitempty" tf:"noncurrent_transition,omitempty"`
+
+	// Status of the rule. Can be either 'Enabled' or 'Disabled'. Defaults to 'Enabled'.
+	// +kubebuilder:validation:Optional
+	Status *string `json:"status,omitempty" tf:"status,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +mapType=granular
+	Tags map[string]*string `json:"tags,omitempty" tf:"tags,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Transition []TransitionParameters `json:"transition,omitempty" tf:"transition,omitempty"`
+}
+
+type TransitionInitParameters struct {
+	Date *string `json:"date,omitempty" tf:"date,omitempty"`
+
+	Days *string `json:"days,omitempty" tf:"days,omitempty"`
+
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/ilm/v1alpha1.Tier
+	StorageClass *string `json:"storageClass,omitempty" tf:"storage_class,omitempty"`
+
+	// Reference to a Tier in ilm to populate storageClass.
+	// +kubebuilder:validation:Optional
+	StorageClassRef *v1.Reference `json:"storageClassRef,omitempty" tf:"-"`
+
+	// Selector for a Tier in ilm to populate storageClass.
+	// +kubebuilder:validation:Optional
+	StorageClassSelector *v1.Selector `json:"storageClassSelector,omitempty" tf:"-"`
+}
+
+type TransitionObservation struct {
+	Date *string `json:"date,omitempty" tf:"date,omitempty"`
+
+	Days *string `json:"days,omitempty" tf:"days,omitempty"`
+
+	StorageClass *string `json:"storageClass,omitempty" tf:"storage_class,omitempty"`
+}
+
+type TransitionParameters struct {
+
+	// +kubebuilder:validation:Optional
+	Date *string `json:"date,omitempty" tf:"date,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Days *string `json:"days,omitempty" tf:"days,omitempty"`
+
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/ilm/v1alpha1.Tier
+	// +kubebuilder:validation:Optional
+	StorageClass *string `json:"storageClass,omitempty" tf:"storage_class,omitempty"`
+
+	// Reference to a Tier in ilm to populate storageClass.
+	// +kubebuilder:validation:Optional
+	StorageClassRef *v1.Reference `json:"storageClassRef,omitempty" tf:"-"`
+
+	// Selector for a Tier in ilm to populate storageClass.
+	// +kubebuilder:validation:Optional
+	StorageClassSelector *v1.Selector `json:"storageClassSelector,omitempty" tf:"-"`
+}
+
+// LifecyclePolicySpec defines the desired state of LifecyclePolicy
+type LifecyclePolicySpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     LifecyclePolicyParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider LifecyclePolicyInitParameters `json:"initProvider,omitempty"`
+}
+
+// LifecyclePolicyStatus defines the observed state of LifecyclePolicy.
+type LifecyclePolicyStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        LifecyclePolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// LifecyclePolicy is the Schema for the LifecyclePolicys API. Manages a MinIO ILM lifecycle policy for a bucket
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,minio}
+type LifecyclePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.rule) || (has(self.initProvider) && has(self.initProvider.rule))",message="spec.forProvider.rule is a required parameter"
+	Spec   LifecyclePolicySpec   `json:"spec"`
+	Status LifecyclePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LifecyclePolicyList contains a list of LifecyclePolicys
+type LifecyclePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LifecyclePolicy `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	LifecyclePolicy_Kind             = "LifecyclePolicy"
+	LifecyclePolicy_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: LifecyclePolicy_Kind}.String()
+	LifecyclePolicy_KindAPIVersion   = LifecyclePolicy_Kind + "." + CRDGroupVersion.String()
+	LifecyclePolicy_GroupVersionKind = CRDGroupVersion.WithKind(LifecyclePolicy_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&LifecyclePolicy{}, &LifecyclePolicyList{})
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2022 Upbound Inc.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ObjectDataParameters identify the object to read.
+type ObjectDataParameters struct {
+
+	// Name of the bucket containing the object
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1.Bucket
+	// +kubebuilder:validation:Optional
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// Reference to a Bucket in s3 to populate bucketName.
+	// +kubebuilder:validation:Optional
+	BucketNameRef *xpv1.Reference `json:"bucketNameRef,omitempty"`
+
+	// Selector for a Bucket in s3 to populate bucketName.
+	// +kubebuilder:validation:Optional
+	BucketNameSelector *xpv1.Selector `json:"bucketNameSelector,omitempty"`
+
+	// Name of the object
+	// +kubebuilder:validation:Required
+	ObjectName *string `json:"objectName"`
+
+	// Version of the object to read. The latest version is read if unset.
+	// +kubebuilder:validation:Optional
+	VersionID *string `json:"versionId,omitempty"`
+}
+
+// ObjectDataObservation is the observed state of the object.
+type ObjectDataObservation struct {
+
+	// Content of the object
+	Content *string `json:"content,omitempty"`
+
+	// Content type of the object
+	ContentType *string `json:"contentType,omitempty"`
+
+	// ETag of the object
+	ETag *string `json:"etag,omitempty"`
+
+	// Last modified time of the object
+	LastModified *metav1.Time `json:"lastModified,omitempty"`
+
+	// Size of the object in bytes
+	Size *int64 `json:"size,omitempty"`
+
+	// Version ID of the object
+	VersionID *string `json:"versionId,omitempty"`
+}
+
+// ObjectDataSpec defines the desired state of ObjectData
+type ObjectDataSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ObjectDataParameters `json:"forProvider"`
+}
+
+// ObjectDataStatus defines the observed state of ObjectData.
+type ObjectDataStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ObjectDataObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ObjectData reads an existing object into its status. It never creates,
+// updates or deletes the object.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ETAG",type="string",JSONPath=".status.atProvider.etag",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,minio}
+type ObjectData struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ObjectDataSpec   `json:"spec"`
+	Status            ObjectDataStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObjectDataList contains a list of ObjectData
+type ObjectDataList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ObjectData `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ObjectData_Kind             = "ObjectData"
+	ObjectData_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ObjectData_Kind}.String()
+	ObjectData_KindAPIVersion   = ObjectData_Kind + "." + CRDGroupVersion.String()
+	ObjectData_GroupVersionKind = CRDGroupVersion.WithKind(ObjectData_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ObjectData{}, &ObjectDataList{})
+}
@@ -31,7 +31,10 @@ type BucketInitParameters struct {
 	ObjectLocking *bool `json:"objectLocking,omitempty" tf:"object_locking,omitempty"`
 
 	// Quota of the bucket
-	Quota *float64 `json:"quota,omitempty" tf:"quota,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="has(self.size) && self.size.matches('^[0-9]+([KMGTPE]i|[kMGTPE])?$')",message="size must be a whole number of bytes or a resource quantity without a fraction, such as 10Gi"
+	// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type == 'hard'",message="type must be hard"
+	// +upjet:crd:field:TFTag=quota_config,omitempty
+	Quota *QuotaInitParameters `json:"quota,omitempty" tf:"quota_config,omitempty"`
 }
 
 type BucketObservation struct {
@@ -60,7 +63,10 @@ type BucketObservation struct {
 	ObjectLocking *bool `json:"objectLocking,omitempty" tf:"object_locking,omitempty"`
 
 	// Quota of the bucket
-	Quota *float64 `json:"quota,omitempty" tf:"quota,omitempty"`
+	Quota *QuotaObservation `json:"quota,omitempty" tf:"quota_config,omitempty"`
+
+	// Quota of the bucket in bytes, as observed by Terraform
+	QuotaBytes *float64 `json:"quotaBytes,omitempty" tf:"quota,omitempty"`
 }
 
 type BucketParameters struct {
@@ -86,8 +92,43 @@ type BucketParameters struct {
 	ObjectLocking *bool `json:"objectLocking,omitempty" tf:"object_locking,omitempty"`
 
 	// Quota of the bucket
+	// +kubebuilder:validation:XValidation:rule="has(self.size) && self.size.matches('^[0-9]+([KMGTPE]i|[kMGTPE])?$')",message="size must be a whole number of bytes or a resource quantity without a fraction, such as 10Gi"
+	// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type == 'hard'",message="type must be hard"
+	// +upjet:crd:field:TFTag=quota_config,omitempty
+	// +kubebuilder:validation:Optional
+	Quota *QuotaParameters `json:"quota,omitempty" tf:"quota_config,omitempty"`
+}
+
+type QuotaInitParameters struct {
+
+	// Size of the quota as a whole number of bytes or a resource quantity without a fraction, for example 10Gi or 500M
+	Size *string `json:"size,omitempty" tf:"size,omitempty"`
+
+	// Type of the quota. MinIO only supports hard quotas, which reject writes that would exceed the size. Defaults to hard
+	Type *string `json:"type,omitempty" tf:"type,omitempty"`
+}
+
+type QuotaObservation struct {
+
+	// Size of the quota as a whole number of bytes or a resource quantity without a fraction, for example 10Gi or 500M
+	Size *string `json:"size,omitempty" tf:"size,omitempty"`
+
+	// Type of the quota. MinIO only supports hard quotas, which reject writes that would exceed the size. Defaults to hard
+	Type *string `json:"type,omitempty" tf:"type,omitempty"`
+
+	// Percentage of the quota used by the objects in the bucket, as reported by the admin API
+	UsagePercentage *float64 `json:"usagePercentage,omitempty" tf:"usage_percentage,omitempty"`
+}
+
+type QuotaParameters struct {
+
+	// Size of the quota as a whole number of bytes or a resource quantity without a fraction, for example 10Gi or 500M
+	// +kubebuilder:validation:Optional
+	Size *string `json:"size" tf:"size,omitempty"`
+
+	// Type of the quota. MinIO only supports hard quotas, which reject writes that would exceed the size. Defaults to hard
 	// +kubebuilder:validation:Optional
-	Quota *float64 `json:"quota,omitempty" tf:"quota,omitempty"`
+	Type *string `json:"type,omitempty" tf:"type,omitempty"`
 }
 
 // BucketSpec defines the desired state of Bucket
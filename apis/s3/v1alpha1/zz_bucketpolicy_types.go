@@ -16,7 +16,19 @@ import (
 type BucketPolicyInitParameters struct {
 
 	// Policy JSON string
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1.PolicyDocument
+	// +crossplane:generate:reference:extractor=github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1.PolicyDocumentJSON()
+	// +crossplane:generate:reference:refFieldName=PolicyDocumentRef
+	// +crossplane:generate:reference:selectorFieldName=PolicyDocumentSelector
 	Policy *string `json:"policy,omitempty" tf:"policy,omitempty"`
+
+	// Reference to a PolicyDocument in iam to populate policy.
+	// +kubebuilder:validation:Optional
+	PolicyDocumentRef *v1.Reference `json:"policyDocumentRef,omitempty" tf:"-"`
+
+	// Selector for a PolicyDocument in iam to populate policy.
+	// +kubebuilder:validation:Optional
+	PolicyDocumentSelector *v1.Selector `json:"policyDocumentSelector,omitempty" tf:"-"`
 }
 
 type BucketPolicyObservation struct {
@@ -29,8 +41,20 @@ type BucketPolicyObservation struct {
 type BucketPolicyParameters struct {
 
 	// Policy JSON string
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1.PolicyDocument
+	// +crossplane:generate:reference:extractor=github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1.PolicyDocumentJSON()
+	// +crossplane:generate:reference:refFieldName=PolicyDocumentRef
+	// +crossplane:generate:reference:selectorFieldName=PolicyDocumentSelector
 	// +kubebuilder:validation:Optional
 	Policy *string `json:"policy,omitempty" tf:"policy,omitempty"`
+
+	// Reference to a PolicyDocument in iam to populate policy.
+	// +kubebuilder:validation:Optional
+	PolicyDocumentRef *v1.Reference `json:"policyDocumentRef,omitempty" tf:"-"`
+
+	// Selector for a PolicyDocument in iam to populate policy.
+	// +kubebuilder:validation:Optional
+	PolicyDocumentSelector *v1.Selector `json:"policyDocumentSelector,omitempty" tf:"-"`
 }
 
 // BucketPolicySpec defines the desired state of BucketPolicy
@@ -69,9 +93,8 @@ type BucketPolicyStatus struct {
 type BucketPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
-	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.policy) || (has(self.initProvider) && has(self.initProvider.policy))",message="spec.forProvider.policy is a required parameter"
-	Spec   BucketPolicySpec   `json:"spec"`
-	Status BucketPolicyStatus `json:"status,omitempty"`
+	Spec              BucketPolicySpec   `json:"spec"`
+	Status            BucketPolicyStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type BucketServerSideEncryptionInitParameters struct {
+
+	// Name of the bucket on which to setup server side encryption
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1.Bucket
+	Bucket *string `json:"bucket,omitempty" tf:"bucket,omitempty"`
+
+	// Reference to a Bucket in s3 to populate bucket.
+	// +kubebuilder:validation:Optional
+	BucketRef *v1.Reference `json:"bucketRef,omitempty" tf:"-"`
+
+	// Selector for a Bucket in s3 to populate bucket.
+	// +kubebuilder:validation:Optional
+	BucketSelector *v1.Selector `json:"bucketSelector,omitempty" tf:"-"`
+
+	// Server side encryption type
+	EncryptionType *string `json:"encryptionType,omitempty" tf:"encryption_type,omitempty"`
+
+	// KMS key id to use for server side encryption
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/kms/v1alpha1.Key
+	KMSKeyID *string `json:"kmsKeyId,omitempty" tf:"kms_key_id,omitempty"`
+
+	// Reference to a Key in kms to populate kmsKeyId.
+	// +kubebuilder:validation:Optional
+	KMSKeyIDRef *v1.Reference `json:"kmsKeyIdRef,omitempty" tf:"-"`
+
+	// Selector for a Key in kms to populate kmsKeyId.
+	// +kubebuilder:validation:Optional
+	KMSKeyIDSelector *v1.Selector `json:"kmsKeyIdSelector,omitempty" tf:"-"`
+}
+
+type BucketServerSideEncryptionObservation struct {
+
+	// Name of the bucket on which to setup server side encryption
+	Bucket *string `json:"bucket,omitempty" tf:"bucket,omitempty"`
+
+	// Server side encryption type
+	EncryptionType *string `json:"encryptionType,omitempty" tf:"encryption_type,omitempty"`
+
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// KMS key id to use for server side encryption
+	KMSKeyID *string `json:"kmsKeyId,omitempty" tf:"kms_key_id,omitempty"`
+}
+
+type BucketServerSideEncryptionParameters struct {
+
+	// Name of the bucket on which to setup server side encryption
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1.Bucket
+	// +kubebuilder:validation:Optional
+	Bucket *string `json:"bucket,omitempty" tf:"bucket,omitempty"`
+
+	// Reference to a Bucket in s3 to populate bucket.
+	// +kubebuilder:validation:Optional
+	BucketRef *v1.Reference `json:"bucketRef,omitempty" tf:"-"`
+
+	// Selector for a Bucket in s3 to populate bucket.
+	// +kubebuilder:validation:Optional
+	BucketSelector *v1.Selector `json:"bucketSelector,omitempty" tf:"-"`
+
+	// Server side encryption type
+	// +kubebuilder:validation:Optional
+	EncryptionType *string `json:"encryptionType,omitempty" tf:"encryption_type,omitempty"`
+
+	// KMS key id to use for server side encryption
+	// +crossplane:generate:reference:type=github.com/markopolo123/provider-upjet-minio/apis/kms/v1alpha1.Key
+	// +kubebuilder:validation:Optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty" tf:"kms_key_id,omitempty"`
+
+	// Reference to a Key in kms to populate kmsKeyId.
+	// +kubebuilder:validation:Optional
+	KMSKeyIDRef *v1.Reference `json:"kmsKeyIdRef,omitempty" tf:"-"`
+
+	// Selector for a Key in kms to populate kmsKeyId.
+	// +kubebuilder:validation:Optional
+	KMSKeyIDSelector *v1.Selector `json:"kmsKeyIdSelector,omitempty" tf:"-"`
+}
+
+// BucketServerSideEncryptionSpec defines the desired state of BucketServerSideEncryption
+type BucketServerSideEncryptionSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     BucketServerSideEncryptionParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider BucketServerSideEncryptionInitParameters `json:"initProvider,omitempty"`
+}
+
+// BucketServerSideEncryptionStatus defines the observed state of BucketServerSideEncryption.
+type BucketServerSideEncryptionStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        BucketServerSideEncryptionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// BucketServerSideEncryption is the Schema for the BucketServerSideEncryptions API. Manages MinIO S3 bucket server side encryption
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,minio}
+type BucketServerSideEncryption struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.encryptionType) || (has(self.initProvider) && has(self.initProvider.encryptionType))",message="spec.forProvider.encryptionType is a required parameter"
+	Spec   BucketServerSideEncryptionSpec   `json:"spec"`
+	Status BucketServerSideEncryptionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BucketServerSideEncryptionList contains a list of BucketServerSideEncryptions
+type BucketServerSideEncryptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BucketServerSideEncryption `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	BucketServerSideEncryption_Kind             = "BucketServerSideEncryption"
+	BucketServerSideEncryption_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: BucketServerSideEncryption_Kind}.String()
+	BucketServerSideEncryption_KindAPIVersion   = BucketServerSideEncryption_Kind + "." + CRDGroupVersion.String()
+	BucketServerSideEncryption_GroupVersionKind = CRDGroupVersion.WithKind(BucketServerSideEncryption_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BucketServerSideEncryption{}, &BucketServerSideEncryptionList{})
+}
@@ -15,6 +15,15 @@ func (tr *BucketNotification) Hub() {}
 // Hub marks this type as a conversion hub.
 func (tr *BucketPolicy) Hub() {}
 
+// Hub marks this type as a conversion hub.
+func (tr *BucketReplication) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *BucketRetention) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *BucketServerSideEncryption) Hub() {}
+
 // Hub marks this type as a conversion hub.
 func (tr *BucketVersioning) Hub() {}
 
@@ -70,8 +70,8 @@ func (in *BucketInitParameters) DeepCopyInto(out *BucketInitParameters) {
 	}
 	if in.Quota != nil {
 		in, out := &in.Quota, &out.Quota
-		*out = new(float64)
-		**out = **in
+		*out = new(QuotaInitParameters)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -327,6 +327,11 @@ func (in *BucketObservation) DeepCopyInto(out *BucketObservation) {
 	}
 	if in.Quota != nil {
 		in, out := &in.Quota, &out.Quota
+		*out = new(QuotaObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QuotaBytes != nil {
+		in, out := &in.QuotaBytes, &out.QuotaBytes
 		*out = new(float64)
 		**out = **in
 	}
@@ -372,8 +377,8 @@ func (in *BucketParameters) DeepCopyInto(out *BucketParameters) {
 	}
 	if in.Quota != nil {
 		in, out := &in.Quota, &out.Quota
-		*out = new(float64)
-		**out = **in
+		*out = new(QuotaParameters)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -422,6 +427,16 @@ func (in *BucketPolicyInitParameters) DeepCopyInto(out *BucketPolicyInitParamete
 		*out = new(string)
 		**out = **in
 	}
+	if in.PolicyDocumentRef != nil {
+		in, out := &in.PolicyDocumentRef, &out.PolicyDocumentRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PolicyDocumentSelector != nil {
+		in, out := &in.PolicyDocumentSelector, &out.PolicyDocumentSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyInitParameters.
@@ -499,6 +514,16 @@ func (in *BucketPolicyParameters) DeepCopyInto(out *BucketPolicyParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PolicyDocumentRef != nil {
+		in, out := &in.PolicyDocumentRef, &out.PolicyDocumentRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PolicyDocumentSelector != nil {
+		in, out := &in.PolicyDocumentSelector, &out.PolicyDocumentSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyParameters.
@@ -547,42 +572,7 @@ func (in *BucketPolicyStatus) DeepCopy() *BucketPolicyStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketSpec) DeepCopyInto(out *BucketSpec) {
-	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
-	in.InitProvider.DeepCopyInto(&out.InitProvider)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketSpec.
-func (in *BucketSpec) DeepCopy() *BucketSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(BucketSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketStatus) DeepCopyInto(out *BucketStatus) {
-	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketStatus.
-func (in *BucketStatus) DeepCopy() *BucketStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(BucketStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketVersioning) DeepCopyInto(out *BucketVersioning) {
+func (in *BucketReplication) DeepCopyInto(out *BucketReplication) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -590,18 +580,18 @@ func (in *BucketVersioning) DeepCopyInto(out *BucketVersioning) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketVersioning.
-func (in *BucketVersioning) DeepCopy() *BucketVersioning {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketReplication.
+func (in *BucketReplication) DeepCopy() *BucketReplication {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketVersioning)
+	out := new(BucketReplication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *BucketVersioning) DeepCopyObject() runtime.Object {
+func (in *BucketReplication) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -609,53 +599,68 @@ func (in *BucketVersioning) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketVersioningInitParameters) DeepCopyInto(out *BucketVersioningInitParameters) {
+func (in *BucketReplicationInitParameters) DeepCopyInto(out *BucketReplicationInitParameters) {
 	*out = *in
-	if in.VersioningConfiguration != nil {
-		in, out := &in.VersioningConfiguration, &out.VersioningConfiguration
-		*out = make([]VersioningConfigurationInitParameters, len(*in))
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rule != nil {
+		in, out := &in.Rule, &out.Rule
+		*out = make([]RuleInitParameters, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketVersioningInitParameters.
-func (in *BucketVersioningInitParameters) DeepCopy() *BucketVersioningInitParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketReplicationInitParameters.
+func (in *BucketReplicationInitParameters) DeepCopy() *BucketReplicationInitParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketVersioningInitParameters)
+	out := new(BucketReplicationInitParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketVersioningList) DeepCopyInto(out *BucketVersioningList) {
+func (in *BucketReplicationList) DeepCopyInto(out *BucketReplicationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]BucketVersioning, len(*in))
+		*out = make([]BucketReplication, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketVersioningList.
-func (in *BucketVersioningList) DeepCopy() *BucketVersioningList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketReplicationList.
+func (in *BucketReplicationList) DeepCopy() *BucketReplicationList {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketVersioningList)
+	out := new(BucketReplicationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *BucketVersioningList) DeepCopyObject() runtime.Object {
+func (in *BucketReplicationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -663,91 +668,111 @@ func (in *BucketVersioningList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketVersioningObservation) DeepCopyInto(out *BucketVersioningObservation) {
+func (in *BucketReplicationObservation) DeepCopyInto(out *BucketReplicationObservation) {
 	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.VersioningConfiguration != nil {
-		in, out := &in.VersioningConfiguration, &out.VersioningConfiguration
-		*out = make([]VersioningConfigurationObservation, len(*in))
+	if in.Rule != nil {
+		in, out := &in.Rule, &out.Rule
+		*out = make([]RuleObservation, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketVersioningObservation.
-func (in *BucketVersioningObservation) DeepCopy() *BucketVersioningObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketReplicationObservation.
+func (in *BucketReplicationObservation) DeepCopy() *BucketReplicationObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketVersioningObservation)
+	out := new(BucketReplicationObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketVersioningParameters) DeepCopyInto(out *BucketVersioningParameters) {
+func (in *BucketReplicationParameters) DeepCopyInto(out *BucketReplicationParameters) {
 	*out = *in
-	if in.VersioningConfiguration != nil {
-		in, out := &in.VersioningConfiguration, &out.VersioningConfiguration
-		*out = make([]VersioningConfigurationParameters, len(*in))
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rule != nil {
+		in, out := &in.Rule, &out.Rule
+		*out = make([]RuleParameters, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketVersioningParameters.
-func (in *BucketVersioningParameters) DeepCopy() *BucketVersioningParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketReplicationParameters.
+func (in *BucketReplicationParameters) DeepCopy() *BucketReplicationParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketVersioningParameters)
+	out := new(BucketReplicationParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketVersioningSpec) DeepCopyInto(out *BucketVersioningSpec) {
+func (in *BucketReplicationSpec) DeepCopyInto(out *BucketReplicationSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 	in.InitProvider.DeepCopyInto(&out.InitProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketVersioningSpec.
-func (in *BucketVersioningSpec) DeepCopy() *BucketVersioningSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketReplicationSpec.
+func (in *BucketReplicationSpec) DeepCopy() *BucketReplicationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketVersioningSpec)
+	out := new(BucketReplicationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketVersioningStatus) DeepCopyInto(out *BucketVersioningStatus) {
+func (in *BucketReplicationStatus) DeepCopyInto(out *BucketReplicationStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketVersioningStatus.
-func (in *BucketVersioningStatus) DeepCopy() *BucketVersioningStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketReplicationStatus.
+func (in *BucketReplicationStatus) DeepCopy() *BucketReplicationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketVersioningStatus)
+	out := new(BucketReplicationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Object) DeepCopyInto(out *Object) {
+func (in *BucketRetention) DeepCopyInto(out *BucketRetention) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -755,18 +780,18 @@ func (in *Object) DeepCopyInto(out *Object) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Object.
-func (in *Object) DeepCopy() *Object {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketRetention.
+func (in *BucketRetention) DeepCopy() *BucketRetention {
 	if in == nil {
 		return nil
 	}
-	out := new(Object)
+	out := new(BucketRetention)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Object) DeepCopyObject() runtime.Object {
+func (in *BucketRetention) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -774,91 +799,76 @@ func (in *Object) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectInitParameters) DeepCopyInto(out *ObjectInitParameters) {
+func (in *BucketRetentionInitParameters) DeepCopyInto(out *BucketRetentionInitParameters) {
 	*out = *in
-	if in.BucketName != nil {
-		in, out := &in.BucketName, &out.BucketName
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
 		*out = new(string)
 		**out = **in
 	}
-	if in.BucketNameRef != nil {
-		in, out := &in.BucketNameRef, &out.BucketNameRef
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
 		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.BucketNameSelector != nil {
-		in, out := &in.BucketNameSelector, &out.BucketNameSelector
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Content != nil {
-		in, out := &in.Content, &out.Content
-		*out = new(string)
-		**out = **in
-	}
-	if in.ContentBase64 != nil {
-		in, out := &in.ContentBase64, &out.ContentBase64
-		*out = new(string)
-		**out = **in
-	}
-	if in.ContentType != nil {
-		in, out := &in.ContentType, &out.ContentType
-		*out = new(string)
-		**out = **in
-	}
-	if in.Etag != nil {
-		in, out := &in.Etag, &out.Etag
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
 		*out = new(string)
 		**out = **in
 	}
-	if in.Source != nil {
-		in, out := &in.Source, &out.Source
+	if in.Unit != nil {
+		in, out := &in.Unit, &out.Unit
 		*out = new(string)
 		**out = **in
 	}
-	if in.VersionID != nil {
-		in, out := &in.VersionID, &out.VersionID
-		*out = new(string)
+	if in.ValidityPeriod != nil {
+		in, out := &in.ValidityPeriod, &out.ValidityPeriod
+		*out = new(float64)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectInitParameters.
-func (in *ObjectInitParameters) DeepCopy() *ObjectInitParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketRetentionInitParameters.
+func (in *BucketRetentionInitParameters) DeepCopy() *BucketRetentionInitParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectInitParameters)
+	out := new(BucketRetentionInitParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectList) DeepCopyInto(out *ObjectList) {
+func (in *BucketRetentionList) DeepCopyInto(out *BucketRetentionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Object, len(*in))
+		*out = make([]BucketRetention, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectList.
-func (in *ObjectList) DeepCopy() *ObjectList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketRetentionList.
+func (in *BucketRetentionList) DeepCopy() *BucketRetentionList {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectList)
+	out := new(BucketRetentionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObjectList) DeepCopyObject() runtime.Object {
+func (in *BucketRetentionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -866,30 +876,10 @@ func (in *ObjectList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectObservation) DeepCopyInto(out *ObjectObservation) {
+func (in *BucketRetentionObservation) DeepCopyInto(out *BucketRetentionObservation) {
 	*out = *in
-	if in.BucketName != nil {
-		in, out := &in.BucketName, &out.BucketName
-		*out = new(string)
-		**out = **in
-	}
-	if in.Content != nil {
-		in, out := &in.Content, &out.Content
-		*out = new(string)
-		**out = **in
-	}
-	if in.ContentBase64 != nil {
-		in, out := &in.ContentBase64, &out.ContentBase64
-		*out = new(string)
-		**out = **in
-	}
-	if in.ContentType != nil {
-		in, out := &in.ContentType, &out.ContentType
-		*out = new(string)
-		**out = **in
-	}
-	if in.Etag != nil {
-		in, out := &in.Etag, &out.Etag
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
 		*out = new(string)
 		**out = **in
 	}
@@ -898,257 +888,1673 @@ func (in *ObjectObservation) DeepCopyInto(out *ObjectObservation) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.Source != nil {
-		in, out := &in.Source, &out.Source
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
 		*out = new(string)
 		**out = **in
 	}
-	if in.VersionID != nil {
-		in, out := &in.VersionID, &out.VersionID
+	if in.Unit != nil {
+		in, out := &in.Unit, &out.Unit
 		*out = new(string)
 		**out = **in
 	}
+	if in.ValidityPeriod != nil {
+		in, out := &in.ValidityPeriod, &out.ValidityPeriod
+		*out = new(float64)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectObservation.
-func (in *ObjectObservation) DeepCopy() *ObjectObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketRetentionObservation.
+func (in *BucketRetentionObservation) DeepCopy() *BucketRetentionObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectObservation)
+	out := new(BucketRetentionObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectParameters) DeepCopyInto(out *ObjectParameters) {
+func (in *BucketRetentionParameters) DeepCopyInto(out *BucketRetentionParameters) {
 	*out = *in
-	if in.BucketName != nil {
-		in, out := &in.BucketName, &out.BucketName
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
 		*out = new(string)
 		**out = **in
 	}
-	if in.BucketNameRef != nil {
-		in, out := &in.BucketNameRef, &out.BucketNameRef
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
 		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.BucketNameSelector != nil {
-		in, out := &in.BucketNameSelector, &out.BucketNameSelector
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Content != nil {
-		in, out := &in.Content, &out.Content
-		*out = new(string)
-		**out = **in
-	}
-	if in.ContentBase64 != nil {
-		in, out := &in.ContentBase64, &out.ContentBase64
-		*out = new(string)
-		**out = **in
-	}
-	if in.ContentType != nil {
-		in, out := &in.ContentType, &out.ContentType
-		*out = new(string)
-		**out = **in
-	}
-	if in.Etag != nil {
-		in, out := &in.Etag, &out.Etag
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
 		*out = new(string)
 		**out = **in
 	}
-	if in.Source != nil {
-		in, out := &in.Source, &out.Source
+	if in.Unit != nil {
+		in, out := &in.Unit, &out.Unit
 		*out = new(string)
 		**out = **in
 	}
-	if in.VersionID != nil {
-		in, out := &in.VersionID, &out.VersionID
-		*out = new(string)
+	if in.ValidityPeriod != nil {
+		in, out := &in.ValidityPeriod, &out.ValidityPeriod
+		*out = new(float64)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectParameters.
-func (in *ObjectParameters) DeepCopy() *ObjectParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketRetentionParameters.
+func (in *BucketRetentionParameters) DeepCopy() *BucketRetentionParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectParameters)
+	out := new(BucketRetentionParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectSpec) DeepCopyInto(out *ObjectSpec) {
+func (in *BucketRetentionSpec) DeepCopyInto(out *BucketRetentionSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 	in.InitProvider.DeepCopyInto(&out.InitProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSpec.
-func (in *ObjectSpec) DeepCopy() *ObjectSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketRetentionSpec.
+func (in *BucketRetentionSpec) DeepCopy() *BucketRetentionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectSpec)
+	out := new(BucketRetentionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectStatus) DeepCopyInto(out *ObjectStatus) {
+func (in *BucketRetentionStatus) DeepCopyInto(out *BucketRetentionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStatus.
-func (in *ObjectStatus) DeepCopy() *ObjectStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketRetentionStatus.
+func (in *BucketRetentionStatus) DeepCopy() *BucketRetentionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectStatus)
+	out := new(BucketRetentionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QueueInitParameters) DeepCopyInto(out *QueueInitParameters) {
+func (in *BucketServerSideEncryption) DeepCopyInto(out *BucketServerSideEncryption) {
 	*out = *in
-	if in.Events != nil {
-		in, out := &in.Events, &out.Events
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketServerSideEncryption.
+func (in *BucketServerSideEncryption) DeepCopy() *BucketServerSideEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketServerSideEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BucketServerSideEncryption) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketServerSideEncryptionInitParameters) DeepCopyInto(out *BucketServerSideEncryptionInitParameters) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EncryptionType != nil {
+		in, out := &in.EncryptionType, &out.EncryptionType
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyIDRef != nil {
+		in, out := &in.KMSKeyIDRef, &out.KMSKeyIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KMSKeyIDSelector != nil {
+		in, out := &in.KMSKeyIDSelector, &out.KMSKeyIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketServerSideEncryptionInitParameters.
+func (in *BucketServerSideEncryptionInitParameters) DeepCopy() *BucketServerSideEncryptionInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketServerSideEncryptionInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketServerSideEncryptionList) DeepCopyInto(out *BucketServerSideEncryptionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BucketServerSideEncryption, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketServerSideEncryptionList.
+func (in *BucketServerSideEncryptionList) DeepCopy() *BucketServerSideEncryptionList {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketServerSideEncryptionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BucketServerSideEncryptionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketServerSideEncryptionObservation) DeepCopyInto(out *BucketServerSideEncryptionObservation) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.EncryptionType != nil {
+		in, out := &in.EncryptionType, &out.EncryptionType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketServerSideEncryptionObservation.
+func (in *BucketServerSideEncryptionObservation) DeepCopy() *BucketServerSideEncryptionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketServerSideEncryptionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketServerSideEncryptionParameters) DeepCopyInto(out *BucketServerSideEncryptionParameters) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EncryptionType != nil {
+		in, out := &in.EncryptionType, &out.EncryptionType
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyIDRef != nil {
+		in, out := &in.KMSKeyIDRef, &out.KMSKeyIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KMSKeyIDSelector != nil {
+		in, out := &in.KMSKeyIDSelector, &out.KMSKeyIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketServerSideEncryptionParameters.
+func (in *BucketServerSideEncryptionParameters) DeepCopy() *BucketServerSideEncryptionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketServerSideEncryptionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketServerSideEncryptionSpec) DeepCopyInto(out *BucketServerSideEncryptionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketServerSideEncryptionSpec.
+func (in *BucketServerSideEncryptionSpec) DeepCopy() *BucketServerSideEncryptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketServerSideEncryptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketServerSideEncryptionStatus) DeepCopyInto(out *BucketServerSideEncryptionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketServerSideEncryptionStatus.
+func (in *BucketServerSideEncryptionStatus) DeepCopy() *BucketServerSideEncryptionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketServerSideEncryptionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketSpec) DeepCopyInto(out *BucketSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketSpec.
+func (in *BucketSpec) DeepCopy() *BucketSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketStatus) DeepCopyInto(out *BucketStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketStatus.
+func (in *BucketStatus) DeepCopy() *BucketStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketVersioning) DeepCopyInto(out *BucketVersioning) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketVersioning.
+func (in *BucketVersioning) DeepCopy() *BucketVersioning {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketVersioning)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BucketVersioning) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketVersioningInitParameters) DeepCopyInto(out *BucketVersioningInitParameters) {
+	*out = *in
+	if in.VersioningConfiguration != nil {
+		in, out := &in.VersioningConfiguration, &out.VersioningConfiguration
+		*out = make([]VersioningConfigurationInitParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketVersioningInitParameters.
+func (in *BucketVersioningInitParameters) DeepCopy() *BucketVersioningInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketVersioningInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketVersioningList) DeepCopyInto(out *BucketVersioningList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BucketVersioning, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketVersioningList.
+func (in *BucketVersioningList) DeepCopy() *BucketVersioningList {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketVersioningList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BucketVersioningList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketVersioningObservation) DeepCopyInto(out *BucketVersioningObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VersioningConfiguration != nil {
+		in, out := &in.VersioningConfiguration, &out.VersioningConfiguration
+		*out = make([]VersioningConfigurationObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketVersioningObservation.
+func (in *BucketVersioningObservation) DeepCopy() *BucketVersioningObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketVersioningObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketVersioningParameters) DeepCopyInto(out *BucketVersioningParameters) {
+	*out = *in
+	if in.VersioningConfiguration != nil {
+		in, out := &in.VersioningConfiguration, &out.VersioningConfiguration
+		*out = make([]VersioningConfigurationParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketVersioningParameters.
+func (in *BucketVersioningParameters) DeepCopy() *BucketVersioningParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketVersioningParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketVersioningSpec) DeepCopyInto(out *BucketVersioningSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketVersioningSpec.
+func (in *BucketVersioningSpec) DeepCopy() *BucketVersioningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketVersioningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketVersioningStatus) DeepCopyInto(out *BucketVersioningStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketVersioningStatus.
+func (in *BucketVersioningStatus) DeepCopy() *BucketVersioningStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketVersioningStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Object) DeepCopyInto(out *Object) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Object.
+func (in *Object) DeepCopy() *Object {
+	if in == nil {
+		return nil
+	}
+	out := new(Object)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Object) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectData) DeepCopyInto(out *ObjectData) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectData.
+func (in *ObjectData) DeepCopy() *ObjectData {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectData) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectDataList) DeepCopyInto(out *ObjectDataList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ObjectData, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectDataList.
+func (in *ObjectDataList) DeepCopy() *ObjectDataList {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectDataList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectDataList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectDataObservation) DeepCopyInto(out *ObjectDataObservation) {
+	*out = *in
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ETag != nil {
+		in, out := &in.ETag, &out.ETag
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastModified != nil {
+		in, out := &in.LastModified, &out.LastModified
+		*out = (*in).DeepCopy()
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VersionID != nil {
+		in, out := &in.VersionID, &out.VersionID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectDataObservation.
+func (in *ObjectDataObservation) DeepCopy() *ObjectDataObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectDataObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectDataParameters) DeepCopyInto(out *ObjectDataParameters) {
+	*out = *in
+	if in.BucketName != nil {
+		in, out := &in.BucketName, &out.BucketName
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketNameRef != nil {
+		in, out := &in.BucketNameRef, &out.BucketNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketNameSelector != nil {
+		in, out := &in.BucketNameSelector, &out.BucketNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ObjectName != nil {
+		in, out := &in.ObjectName, &out.ObjectName
+		*out = new(string)
+		**out = **in
+	}
+	if in.VersionID != nil {
+		in, out := &in.VersionID, &out.VersionID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectDataParameters.
+func (in *ObjectDataParameters) DeepCopy() *ObjectDataParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectDataParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectDataSpec) DeepCopyInto(out *ObjectDataSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectDataSpec.
+func (in *ObjectDataSpec) DeepCopy() *ObjectDataSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectDataSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectDataStatus) DeepCopyInto(out *ObjectDataStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectDataStatus.
+func (in *ObjectDataStatus) DeepCopy() *ObjectDataStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectDataStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectInitParameters) DeepCopyInto(out *ObjectInitParameters) {
+	*out = *in
+	if in.BucketName != nil {
+		in, out := &in.BucketName, &out.BucketName
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketNameRef != nil {
+		in, out := &in.BucketNameRef, &out.BucketNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketNameSelector != nil {
+		in, out := &in.BucketNameSelector, &out.BucketNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentBase64 != nil {
+		in, out := &in.ContentBase64, &out.ContentBase64
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Etag != nil {
+		in, out := &in.Etag, &out.Etag
+		*out = new(string)
+		**out = **in
+	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(string)
+		**out = **in
+	}
+	if in.VersionID != nil {
+		in, out := &in.VersionID, &out.VersionID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectInitParameters.
+func (in *ObjectInitParameters) DeepCopy() *ObjectInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectList) DeepCopyInto(out *ObjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Object, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectList.
+func (in *ObjectList) DeepCopy() *ObjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectObservation) DeepCopyInto(out *ObjectObservation) {
+	*out = *in
+	if in.BucketName != nil {
+		in, out := &in.BucketName, &out.BucketName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentBase64 != nil {
+		in, out := &in.ContentBase64, &out.ContentBase64
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Etag != nil {
+		in, out := &in.Etag, &out.Etag
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(string)
+		**out = **in
+	}
+	if in.VersionID != nil {
+		in, out := &in.VersionID, &out.VersionID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectObservation.
+func (in *ObjectObservation) DeepCopy() *ObjectObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectParameters) DeepCopyInto(out *ObjectParameters) {
+	*out = *in
+	if in.BucketName != nil {
+		in, out := &in.BucketName, &out.BucketName
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketNameRef != nil {
+		in, out := &in.BucketNameRef, &out.BucketNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketNameSelector != nil {
+		in, out := &in.BucketNameSelector, &out.BucketNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentBase64 != nil {
+		in, out := &in.ContentBase64, &out.ContentBase64
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Etag != nil {
+		in, out := &in.Etag, &out.Etag
+		*out = new(string)
+		**out = **in
+	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(string)
+		**out = **in
+	}
+	if in.VersionID != nil {
+		in, out := &in.VersionID, &out.VersionID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectParameters.
+func (in *ObjectParameters) DeepCopy() *ObjectParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSpec) DeepCopyInto(out *ObjectSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSpec.
+func (in *ObjectSpec) DeepCopy() *ObjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStatus) DeepCopyInto(out *ObjectStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStatus.
+func (in *ObjectStatus) DeepCopy() *ObjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueInitParameters) DeepCopyInto(out *QueueInitParameters) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.FilterPrefix != nil {
+		in, out := &in.FilterPrefix, &out.FilterPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.FilterSuffix != nil {
+		in, out := &in.FilterSuffix, &out.FilterSuffix
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueueArn != nil {
+		in, out := &in.QueueArn, &out.QueueArn
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueInitParameters.
+func (in *QueueInitParameters) DeepCopy() *QueueInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueObservation) DeepCopyInto(out *QueueObservation) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.FilterPrefix != nil {
+		in, out := &in.FilterPrefix, &out.FilterPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.FilterSuffix != nil {
+		in, out := &in.FilterSuffix, &out.FilterSuffix
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueueArn != nil {
+		in, out := &in.QueueArn, &out.QueueArn
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueObservation.
+func (in *QueueObservation) DeepCopy() *QueueObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueParameters) DeepCopyInto(out *QueueParameters) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
 		}
 	}
-	if in.FilterPrefix != nil {
-		in, out := &in.FilterPrefix, &out.FilterPrefix
+	if in.FilterPrefix != nil {
+		in, out := &in.FilterPrefix, &out.FilterPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.FilterSuffix != nil {
+		in, out := &in.FilterSuffix, &out.FilterSuffix
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueueArn != nil {
+		in, out := &in.QueueArn, &out.QueueArn
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueParameters.
+func (in *QueueParameters) DeepCopy() *QueueParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaInitParameters) DeepCopyInto(out *QuotaInitParameters) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaInitParameters.
+func (in *QuotaInitParameters) DeepCopy() *QuotaInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaObservation) DeepCopyInto(out *QuotaObservation) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.UsagePercentage != nil {
+		in, out := &in.UsagePercentage, &out.UsagePercentage
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaObservation.
+func (in *QuotaObservation) DeepCopy() *QuotaObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaParameters) DeepCopyInto(out *QuotaParameters) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaParameters.
+func (in *QuotaParameters) DeepCopy() *QuotaParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleInitParameters) DeepCopyInto(out *RuleInitParameters) {
+	*out = *in
+	if in.DeleteMarkerReplication != nil {
+		in, out := &in.DeleteMarkerReplication, &out.DeleteMarkerReplication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeleteReplication != nil {
+		in, out := &in.DeleteReplication, &out.DeleteReplication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExistingObjectReplication != nil {
+		in, out := &in.ExistingObjectReplication, &out.ExistingObjectReplication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MetadataSync != nil {
+		in, out := &in.MetadataSync, &out.MetadataSync
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
 		*out = new(string)
 		**out = **in
 	}
-	if in.FilterSuffix != nil {
-		in, out := &in.FilterSuffix, &out.FilterSuffix
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = make([]TargetInitParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleInitParameters.
+func (in *RuleInitParameters) DeepCopy() *RuleInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleObservation) DeepCopyInto(out *RuleObservation) {
+	*out = *in
+	if in.Arn != nil {
+		in, out := &in.Arn, &out.Arn
 		*out = new(string)
 		**out = **in
 	}
+	if in.DeleteMarkerReplication != nil {
+		in, out := &in.DeleteMarkerReplication, &out.DeleteMarkerReplication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeleteReplication != nil {
+		in, out := &in.DeleteReplication, &out.DeleteReplication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExistingObjectReplication != nil {
+		in, out := &in.ExistingObjectReplication, &out.ExistingObjectReplication
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.QueueArn != nil {
-		in, out := &in.QueueArn, &out.QueueArn
+	if in.MetadataSync != nil {
+		in, out := &in.MetadataSync, &out.MetadataSync
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
 		*out = new(string)
 		**out = **in
 	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = make([]TargetObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueInitParameters.
-func (in *QueueInitParameters) DeepCopy() *QueueInitParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleObservation.
+func (in *RuleObservation) DeepCopy() *RuleObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(QueueInitParameters)
+	out := new(RuleObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QueueObservation) DeepCopyInto(out *QueueObservation) {
+func (in *RuleParameters) DeepCopyInto(out *RuleParameters) {
 	*out = *in
-	if in.Events != nil {
-		in, out := &in.Events, &out.Events
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
+	if in.DeleteMarkerReplication != nil {
+		in, out := &in.DeleteMarkerReplication, &out.DeleteMarkerReplication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeleteReplication != nil {
+		in, out := &in.DeleteReplication, &out.DeleteReplication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExistingObjectReplication != nil {
+		in, out := &in.ExistingObjectReplication, &out.ExistingObjectReplication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MetadataSync != nil {
+		in, out := &in.MetadataSync, &out.MetadataSync
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
 				*out = new(string)
 				**out = **in
 			}
+			(*out)[key] = outVal
 		}
 	}
-	if in.FilterPrefix != nil {
-		in, out := &in.FilterPrefix, &out.FilterPrefix
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = make([]TargetParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleParameters.
+func (in *RuleParameters) DeepCopy() *RuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetInitParameters) DeepCopyInto(out *TargetInitParameters) {
+	*out = *in
+	out.AccessKeySecretRef = in.AccessKeySecretRef
+	if in.BandwidthLimit != nil {
+		in, out := &in.BandwidthLimit, &out.BandwidthLimit
 		*out = new(string)
 		**out = **in
 	}
-	if in.FilterSuffix != nil {
-		in, out := &in.FilterSuffix, &out.FilterSuffix
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
 		*out = new(string)
 		**out = **in
 	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisableProxy != nil {
+		in, out := &in.DisableProxy, &out.DisableProxy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HealthCheckPeriod != nil {
+		in, out := &in.HealthCheckPeriod, &out.HealthCheckPeriod
 		*out = new(string)
 		**out = **in
 	}
-	if in.QueueArn != nil {
-		in, out := &in.QueueArn, &out.QueueArn
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.PathStyle != nil {
+		in, out := &in.PathStyle, &out.PathStyle
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
 		*out = new(string)
 		**out = **in
 	}
+	if in.SecretKeySecretRef != nil {
+		in, out := &in.SecretKeySecretRef, &out.SecretKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.Secure != nil {
+		in, out := &in.Secure, &out.Secure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StorageClass != nil {
+		in, out := &in.StorageClass, &out.StorageClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.Syncronous != nil {
+		in, out := &in.Syncronous, &out.Syncronous
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueObservation.
-func (in *QueueObservation) DeepCopy() *QueueObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetInitParameters.
+func (in *TargetInitParameters) DeepCopy() *TargetInitParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(QueueObservation)
+	out := new(TargetInitParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QueueParameters) DeepCopyInto(out *QueueParameters) {
+func (in *TargetObservation) DeepCopyInto(out *TargetObservation) {
 	*out = *in
-	if in.Events != nil {
-		in, out := &in.Events, &out.Events
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
+	if in.BandwidthLimit != nil {
+		in, out := &in.BandwidthLimit, &out.BandwidthLimit
+		*out = new(string)
+		**out = **in
 	}
-	if in.FilterPrefix != nil {
-		in, out := &in.FilterPrefix, &out.FilterPrefix
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
 		*out = new(string)
 		**out = **in
 	}
-	if in.FilterSuffix != nil {
-		in, out := &in.FilterSuffix, &out.FilterSuffix
+	if in.DisableProxy != nil {
+		in, out := &in.DisableProxy, &out.DisableProxy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HealthCheckPeriod != nil {
+		in, out := &in.HealthCheckPeriod, &out.HealthCheckPeriod
 		*out = new(string)
 		**out = **in
 	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
 		*out = new(string)
 		**out = **in
 	}
-	if in.QueueArn != nil {
-		in, out := &in.QueueArn, &out.QueueArn
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.PathStyle != nil {
+		in, out := &in.PathStyle, &out.PathStyle
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.Secure != nil {
+		in, out := &in.Secure, &out.Secure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StorageClass != nil {
+		in, out := &in.StorageClass, &out.StorageClass
 		*out = new(string)
 		**out = **in
 	}
+	if in.Syncronous != nil {
+		in, out := &in.Syncronous, &out.Syncronous
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueParameters.
-func (in *QueueParameters) DeepCopy() *QueueParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetObservation.
+func (in *TargetObservation) DeepCopy() *TargetObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(QueueParameters)
+	out := new(TargetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetParameters) DeepCopyInto(out *TargetParameters) {
+	*out = *in
+	out.AccessKeySecretRef = in.AccessKeySecretRef
+	if in.BandwidthLimit != nil {
+		in, out := &in.BandwidthLimit, &out.BandwidthLimit
+		*out = new(string)
+		**out = **in
+	}
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisableProxy != nil {
+		in, out := &in.DisableProxy, &out.DisableProxy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HealthCheckPeriod != nil {
+		in, out := &in.HealthCheckPeriod, &out.HealthCheckPeriod
+		*out = new(string)
+		**out = **in
+	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.PathStyle != nil {
+		in, out := &in.PathStyle, &out.PathStyle
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecretKeySecretRef != nil {
+		in, out := &in.SecretKeySecretRef, &out.SecretKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.Secure != nil {
+		in, out := &in.Secure, &out.Secure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StorageClass != nil {
+		in, out := &in.StorageClass, &out.StorageClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.Syncronous != nil {
+		in, out := &in.Syncronous, &out.Syncronous
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetParameters.
+func (in *TargetParameters) DeepCopy() *TargetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetParameters)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -35,6 +35,33 @@ func (l *BucketPolicyList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this BucketReplicationList.
+func (l *BucketReplicationList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this BucketRetentionList.
+func (l *BucketRetentionList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this BucketServerSideEncryptionList.
+func (l *BucketServerSideEncryptionList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this BucketVersioningList.
 func (l *BucketVersioningList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -44,6 +71,15 @@ func (l *BucketVersioningList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this ObjectDataList.
+func (l *ObjectDataList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this ObjectList.
 func (l *ObjectList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
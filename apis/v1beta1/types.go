@@ -11,30 +11,183 @@ import (
 )
 
 // A ProviderConfigSpec defines the desired state of a ProviderConfig.
+// +kubebuilder:validation:XValidation:rule="!has(self.insecure) || !self.insecure || !has(self.ssl) || self.ssl",message="insecure cannot be set when ssl is false"
+// +kubebuilder:validation:XValidation:rule="!has(self.caBundleSecretRef) || !has(self.ssl) || self.ssl",message="caBundleSecretRef cannot be set when ssl is false"
 type ProviderConfigSpec struct {
-	// Credentials required to authenticate to this provider.
+	// Credentials required to authenticate to this provider. The fields
+	// below take precedence over the same settings in the credentials.
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// Server is the MinIO endpoint in the form host:port, without a scheme.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="!self.contains('://')",message="server must not include a scheme such as https://"
+	Server *string `json:"server,omitempty"`
+
+	// SSL enables HTTPS when connecting to MinIO.
+	// +kubebuilder:validation:Optional
+	SSL *bool `json:"ssl,omitempty"`
+
+	// Insecure skips verification of the server certificate.
+	// +kubebuilder:validation:Optional
+	Insecure *bool `json:"insecure,omitempty"`
+
+	// Region of the MinIO server.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	Region *string `json:"region,omitempty"`
+
+	// APIVersion is the signature version used to sign requests.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=v2;v4
+	APIVersion *string `json:"apiVersion,omitempty"`
+
+	// CABundleSecretRef references a PEM encoded CA bundle used to verify
+	// the server certificate.
+	// +kubebuilder:validation:Optional
+	CABundleSecretRef *xpv1.SecretKeySelector `json:"caBundleSecretRef,omitempty"`
+
+	// HealthCheckInterval is how often the credentials are re-validated.
+	// While MinIO is unavailable they are re-validated with an exponential
+	// backoff, up to this interval. Set it to 0s to disable re-validation.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="5m"
+	HealthCheckInterval *metav1.Duration `json:"healthCheckInterval,omitempty"`
+
+	// ReconcileLimits limit how often and how many of the managed resources
+	// that use this ProviderConfig are reconciled, so that one MinIO server
+	// cannot use up the reconciles of the provider.
+	// +kubebuilder:validation:Optional
+	ReconcileLimits *ReconcileLimits `json:"reconcileLimits,omitempty"`
+}
+
+// ReconcileLimits limit the reconciles of the managed resources of a
+// ProviderConfig. A reconcile over a limit waits briefly, and is then retried
+// with a backoff.
+// +kubebuilder:validation:XValidation:rule="!has(self.burst) || has(self.ratePerSecond)",message="burst can only be set with ratePerSecond"
+type ReconcileLimits struct {
+	// RatePerSecond is the maximum rate at which the managed resources are
+	// reconciled.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	RatePerSecond *int64 `json:"ratePerSecond,omitempty"`
+
+	// Burst is the number of reconciles that may start at once before
+	// RatePerSecond applies. Defaults to RatePerSecond.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	Burst *int64 `json:"burst,omitempty"`
+
+	// MaxConcurrentReconciles is the maximum number of managed resources
+	// that are reconciled at the same time. Terraform operations that a
+	// reconcile starts asynchronously are not counted once it returns.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrentReconciles *int64 `json:"maxConcurrentReconciles,omitempty"`
 }
 
+// A CredentialsMode selects the keys that credentials consist of.
+type CredentialsMode string
+
+// Supported credentials modes.
+const (
+	// CredentialsModeStatic credentials are the minio_user and
+	// minio_password of a user, such as the root user.
+	CredentialsModeStatic CredentialsMode = "Static"
+
+	// CredentialsModeAccessKey credentials are the minio_access_key and
+	// minio_secret_key of an access key or service account.
+	CredentialsModeAccessKey CredentialsMode = "AccessKey"
+
+	// CredentialsModeSessionToken credentials are temporary STS credentials
+	// consisting of minio_access_key, minio_secret_key and
+	// minio_session_token.
+	CredentialsModeSessionToken CredentialsMode = "SessionToken"
+)
+
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
 	// Source of the provider credentials.
 	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
 	Source xpv1.CredentialsSource `json:"source"`
 
+	// Mode selects the keys the credentials consist of. Static uses
+	// minio_user and minio_password, AccessKey uses minio_access_key and
+	// minio_secret_key, and SessionToken additionally uses the
+	// minio_session_token of temporary STS credentials.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Static;AccessKey;SessionToken
+	// +kubebuilder:default=Static
+	Mode CredentialsMode `json:"mode,omitempty"`
+
+	// WebIdentity configures how the service account token of the provider
+	// pod is exchanged for temporary credentials when the source is
+	// InjectedIdentity. The mode is ignored for that source.
+	// +kubebuilder:validation:Optional
+	WebIdentity *WebIdentity `json:"webIdentity,omitempty"`
+
 	xpv1.CommonCredentialSelectors `json:",inline"`
 }
 
+// WebIdentity configures the AssumeRoleWithWebIdentity call that exchanges
+// a service account token for temporary MinIO credentials.
+type WebIdentity struct {
+	// TokenPath is the path of the projected service account token in the
+	// provider pod. Its audience must be accepted by the OpenID provider
+	// configured in MinIO.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="/var/run/secrets/kubernetes.io/serviceaccount/token"
+	TokenPath *string `json:"tokenPath,omitempty"`
+
+	// RoleARN is the role policy to assume, for OpenID providers that are
+	// configured with a role policy in MinIO.
+	// +kubebuilder:validation:Optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// DurationSeconds is how long the temporary credentials are valid.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=900
+	// +kubebuilder:validation:Maximum=604800
+	DurationSeconds *int64 `json:"durationSeconds,omitempty"`
+}
+
 // A ProviderConfigStatus reflects the observed state of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// ServerVersion is the version of the MinIO server.
+	// +optional
+	ServerVersion *string `json:"serverVersion,omitempty"`
+
+	// Region is the region reported by the MinIO server.
+	// +optional
+	Region *string `json:"region,omitempty"`
+
+	// DeploymentID is the ID of the MinIO deployment.
+	// +optional
+	DeploymentID *string `json:"deploymentID,omitempty"`
+
+	// OnlineDrives is the number of online drives of the MinIO deployment.
+	// +optional
+	OnlineDrives *int64 `json:"onlineDrives,omitempty"`
+
+	// LastValidatedTime is the last time the credentials were validated
+	// successfully.
+	// +optional
+	LastValidatedTime *metav1.Time `json:"lastValidatedTime,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 
 // A ProviderConfig configures a MinIO provider.
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.serverVersion"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".status.region"
+// +kubebuilder:printcolumn:name="DRIVES",type="integer",JSONPath=".status.onlineDrives"
+// +kubebuilder:printcolumn:name="VALIDATED",type="date",JSONPath=".status.lastValidatedTime"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DEPLOYMENT-ID",type="string",JSONPath=".status.deploymentID",priority=1
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,minio}
@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	v1alpha1 "github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1"
+	v1alpha1ilm "github.com/markopolo123/provider-upjet-minio/apis/ilm/v1alpha1"
 	v1alpha1kms "github.com/markopolo123/provider-upjet-minio/apis/kms/v1alpha1"
 	v1alpha1s3 "github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1"
 	v1alpha1apis "github.com/markopolo123/provider-upjet-minio/apis/v1alpha1"
@@ -21,6 +22,7 @@ func init() {
 	// Register the types with the Scheme so the components can map objects to GroupVersionKinds and back
 	AddToSchemes = append(AddToSchemes,
 		v1alpha1.SchemeBuilder.AddToScheme,
+		v1alpha1ilm.SchemeBuilder.AddToScheme,
 		v1alpha1kms.SchemeBuilder.AddToScheme,
 		v1alpha1s3.SchemeBuilder.AddToScheme,
 		v1alpha1apis.SchemeBuilder.AddToScheme,
@@ -0,0 +1,58 @@
+#!/usr/bin/env bash
+set -aeuo pipefail
+
+# Measures how long the provider takes to create and delete a number of
+# Buckets. Run it against the Kind cluster from bootstrap-minio once with the
+# provider forking a Terraform provider process for every operation, its
+# default, and once with --terraform-native-provider-path set to share them,
+# and compare the results.
+
+KUBECTL=${KUBECTL:-kubectl}
+BUCKETS=${BUCKETS:-50}
+PROVIDER_CONFIG=${PROVIDER_CONFIG:-default}
+TIMEOUT=${TIMEOUT:-30m}
+# MODE names the provider configuration under test in the results, e.g.
+# "fork" or "shared".
+MODE=${MODE:-fork}
+
+manifests() {
+    for i in $(seq 1 "${BUCKETS}"); do
+        cat <<EOF
+---
+apiVersion: s3.minio.crossplane.io/v1alpha1
+kind: Bucket
+metadata:
+  name: benchmark-${i}
+  labels:
+    testing.upbound.io/benchmark: "true"
+spec:
+  forProvider:
+    bucket: benchmark-${i}
+    forceDestroy: true
+  providerConfigRef:
+    name: ${PROVIDER_CONFIG}
+EOF
+    done
+}
+
+echo "Environment:"
+echo "  mode: ${MODE}"
+echo "  kubernetes: $(${KUBECTL} version -o json | awk -F'"' '/gitVersion/ { v = $4 } END { print v }')"
+${KUBECTL} get nodes -o jsonpath='{range .items[*]}  node {.metadata.name}: {.status.capacity.cpu} CPUs, {.status.capacity.memory} memory{"\n"}{end}'
+echo "  minio: $(${KUBECTL} get deployment minio -n minio-system -o jsonpath='{.spec.template.spec.containers[0].image}' 2>/dev/null || echo unknown)"
+
+echo "Creating ${BUCKETS} Buckets with ProviderConfig ${PROVIDER_CONFIG}..."
+start=$(date +%s)
+manifests | ${KUBECTL} apply -f - >/dev/null
+${KUBECTL} wait bucket.s3.minio.crossplane.io -l testing.upbound.io/benchmark=true --for=condition=Ready --timeout="${TIMEOUT}" >/dev/null
+created=$(date +%s)
+
+echo "Deleting ${BUCKETS} Buckets..."
+${KUBECTL} delete bucket.s3.minio.crossplane.io -l testing.upbound.io/benchmark=true --wait=true --timeout="${TIMEOUT}" >/dev/null
+deleted=$(date +%s)
+
+create=$((created - start))
+delete=$((deleted - created))
+echo "Results (${MODE}):"
+echo "Created ${BUCKETS} Buckets in ${create}s ($(awk "BEGIN { printf \"%.2f\", ${BUCKETS} / (${create} > 0 ? ${create} : 1) }") Buckets/s)"
+echo "Deleted ${BUCKETS} Buckets in ${delete}s ($(awk "BEGIN { printf \"%.2f\", ${BUCKETS} / (${delete} > 0 ? ${delete} : 1) }") Buckets/s)"
@@ -175,10 +175,78 @@ spec:
   type: ClusterIP
 EOF
 
+# Deploy a second Minio to act as the remote site for replication tests
+echo "Creating peer Minio deployment..."
+cat <<EOF | kubectl apply -f -
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: minio-peer
+  namespace: ${MINIO_NAMESPACE}
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: minio-peer
+  template:
+    metadata:
+      labels:
+        app: minio-peer
+    spec:
+      containers:
+      - name: minio
+        image: quay.io/minio/minio:RELEASE.2024-10-29T16-01-48Z
+        command:
+        - /bin/bash
+        - -c
+        args:
+        - minio server /data --console-address :9001
+        env:
+        - name: MINIO_ROOT_USER
+          value: "testuser"
+        - name: MINIO_ROOT_PASSWORD
+          value: "testpassword123"
+        ports:
+        - containerPort: 9000
+          name: api
+        - containerPort: 9001
+          name: console
+        volumeMounts:
+        - name: storage
+          mountPath: /data
+        readinessProbe:
+          httpGet:
+            path: /minio/health/ready
+            port: 9000
+          initialDelaySeconds: 10
+          periodSeconds: 10
+      volumes:
+      - name: storage
+        emptyDir: {}
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: minio-peer-api
+  namespace: ${MINIO_NAMESPACE}
+spec:
+  selector:
+    app: minio-peer
+  ports:
+  - name: api
+    port: 9000
+    targetPort: 9000
+  type: ClusterIP
+EOF
+
+echo "Waiting for peer Minio to be ready..."
+kubectl wait --for=condition=Available deployment/minio-peer --namespace=${MINIO_NAMESPACE} --timeout=300s
+
 echo "=== Kind cluster bootstrap complete ==="
 echo "Cluster name: ${CLUSTER_NAME}"
 echo "Minio namespace: ${MINIO_NAMESPACE}"
 echo "Minio credentials: minioadmin/minioadmin"
+echo "Peer Minio credentials: testuser/testpassword123"
 echo ""
 echo "To use this cluster:"
 echo "  kubectl config use-context kind-${CLUSTER_NAME}"
@@ -15,6 +15,11 @@ MINIO_REGION=${MINIO_REGION:-"us-east-1"}
 MINIO_SSL=${MINIO_SSL:-"false"}
 MINIO_INSECURE=${MINIO_INSECURE:-"false"}
 
+# Peer Minio used as the remote site for replication tests
+MINIO_PEER_SERVER=${MINIO_PEER_SERVER:-"minio-peer-api.minio-system.svc.cluster.local:9000"}
+MINIO_PEER_USER=${MINIO_PEER_USER:-"${MINIO_USER}"}
+MINIO_PEER_PASSWORD=${MINIO_PEER_PASSWORD:-"${MINIO_PASSWORD}"}
+
 # Use provided cloud credentials or create default Minio credentials
 if [ -n "${UPTEST_CLOUD_CREDENTIALS:-}" ]; then
     echo "Using provided UPTEST_CLOUD_CREDENTIALS..."
@@ -38,15 +43,30 @@ EOF
     ${KUBECTL} -n upbound-system create secret generic provider-secret --from-literal=credentials="${MINIO_CREDS}" --dry-run=client -o yaml | ${KUBECTL} apply -f -
 fi
 
+echo "Creating peer Minio credentials..."
+echo "  Server: ${MINIO_PEER_SERVER}"
+MINIO_PEER_CREDS=$(cat <<EOF
+{
+  "minio_server": "${MINIO_PEER_SERVER}",
+  "minio_user": "${MINIO_PEER_USER}",
+  "minio_password": "${MINIO_PEER_PASSWORD}",
+  "minio_region": "${MINIO_REGION}",
+  "minio_ssl": "${MINIO_SSL}",
+  "minio_insecure": "${MINIO_INSECURE}"
+}
+EOF
+)
+${KUBECTL} -n upbound-system create secret generic provider-secret-peer --from-literal=credentials="${MINIO_PEER_CREDS}" --dry-run=client -o yaml | ${KUBECTL} apply -f -
+
 echo "Waiting until provider is healthy..."
 ${KUBECTL} wait provider.pkg --all --for condition=Healthy --timeout 5m
 
 echo "Waiting for all pods to come online..."
 ${KUBECTL} -n upbound-system wait --for=condition=Available deployment --all --timeout=5m
 
-echo "Creating a default provider config..."
+echo "Creating default and peer provider configs..."
 cat <<EOF | ${KUBECTL} apply -f -
-apiVersion: template.upbound.io/v1beta1
+apiVersion: minio.crossplane.io/v1beta1
 kind: ProviderConfig
 metadata:
   name: default
@@ -57,6 +77,18 @@ spec:
       name: provider-secret
       namespace: upbound-system
       key: credentials
+---
+apiVersion: minio.crossplane.io/v1beta1
+kind: ProviderConfig
+metadata:
+  name: peer
+spec:
+  credentials:
+    source: Secret
+    secretRef:
+      name: provider-secret-peer
+      namespace: upbound-system
+      key: credentials
 EOF
 
 # Wait for provider to be ready after configuration
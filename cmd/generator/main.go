@@ -24,4 +24,10 @@ func main() {
 		panic(fmt.Sprintf("cannot calculate the absolute path with %s", rootDir))
 	}
 	pipeline.Run(config.GetProvider(), absRootDir)
+	if err := config.RemoveObservationValidations(filepath.Join(absRootDir, "apis")); err != nil {
+		panic(fmt.Sprintf("cannot remove the validation rules of the observations: %v", err))
+	}
+	if err := config.ReplaceConnectors(filepath.Join(absRootDir, "internal", "controller")); err != nil {
+		panic(fmt.Sprintf("cannot replace the connectors of the controllers: %v", err))
+	}
 }
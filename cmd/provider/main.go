@@ -23,11 +23,13 @@ import (
 	tjcontroller "github.com/crossplane/upjet/pkg/controller"
 	"github.com/crossplane/upjet/pkg/terraform"
 	"gopkg.in/alecthomas/kingpin.v2"
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
@@ -46,6 +48,7 @@ func main() {
 		syncPeriod              = app.Flag("sync", "Controller manager sync period such as 300ms, 1.5h, or 2h45m").Short('s').Default("1h").Duration()
 		pollInterval            = app.Flag("poll", "Poll interval controls how often an individual resource should be checked for drift.").Default("10m").Duration()
 		pollStateMetricInterval = app.Flag("poll-state-metric", "State metric recording interval").Default("5s").Duration()
+		pollBucketUsage         = app.Flag("poll-bucket-usage", "How often the usage of Ready Buckets is read from the MinIO admin API for the minio_bucket_* metrics. Set to 0 to disable the metrics.").Default("1m").Duration()
 		leaderElection          = app.Flag("leader-election", "Use leader election for the controller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
 		maxReconcileRate        = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may be checked for drift from the desired state.").Default("10").Int()
 
@@ -57,6 +60,10 @@ func main() {
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
 		enableManagementPolicies   = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("true").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
 		essTLSCertsPath            = app.Flag("ess-tls-cert-dir", "Path of ESS TLS certificates.").Envar("ESS_TLS_CERTS_DIR").String()
+
+		nativeProviderPath = app.Flag("terraform-native-provider-path", "Path of the Terraform provider binary. If set, a provider process is shared by the resources with the same provider configuration instead of being started for every Terraform operation.").Default("").String()
+		providerTTL        = app.Flag("provider-ttl", "The number of Terraform operations a shared provider process serves before it is restarted.").Default("100").Envar("PROVIDER_TTL").Int()
+		nativeClients      = app.Flag("native-client", "Reconcile the supplied kind with the MinIO Go SDKs instead of Terraform. One of Bucket, BucketVersioning, Group or Policy. May be repeated.").Strings()
 	)
 
 	kingpin.MustParse(app.Parse(os.Args[1:]))
@@ -81,6 +88,12 @@ func main() {
 		Cache: cache.Options{
 			SyncPeriod: syncPeriod,
 		},
+		// Secrets are read from the API server rather than from a cluster
+		// wide informer that holds all of their data. The credentials cache
+		// watches their metadata to know when to read them again.
+		Client: client.Options{
+			Cache: &client.CacheOptions{DisableFor: []client.Object{&corev1.Secret{}}},
+		},
 		LeaderElectionResourceLock: resourcelock.LeasesResourceLock,
 		LeaseDuration:              func() *time.Duration { d := 60 * time.Second; return &d }(),
 		RenewDeadline:              func() *time.Duration { d := 50 * time.Second; return &d }(),
@@ -93,6 +106,24 @@ func main() {
 
 	metrics.Registry.MustRegister(metricRecorder)
 	metrics.Registry.MustRegister(stateMetrics)
+	metrics.Registry.MustRegister(clients.DefaultCredentialsCache)
+	metrics.Registry.MustRegister(clients.DefaultReconcileLimiter)
+
+	if *pollBucketUsage > 0 {
+		usage := clients.NewBucketUsageCollector(mgr.GetClient(), log, *pollBucketUsage, clients.DefaultDataUsageCache)
+		metrics.Registry.MustRegister(usage)
+		kingpin.FatalIfError(mgr.Add(usage), "Cannot add bucket usage collector")
+	}
+
+	scheduler := terraform.ProviderScheduler(terraform.NewNoOpProviderScheduler())
+	if *nativeProviderPath != "" {
+		log.Info("Sharing Terraform provider processes", "path", *nativeProviderPath, "ttl", *providerTTL)
+		scheduler = terraform.NewSharedProviderScheduler(log, *providerTTL,
+			terraform.WithSharedProviderOptions(
+				terraform.WithNativeProviderPath(*nativeProviderPath),
+				terraform.WithNativeProviderName("registry.terraform.io/"+*providerSource),
+			))
+	}
 
 	o := tjcontroller.Options{
 		Options: xpcontroller.Options{
@@ -107,11 +138,9 @@ func main() {
 				MRStateMetrics:          stateMetrics,
 			},
 		},
-		Provider: config.GetProvider(),
-		// use the following WorkspaceStoreOption to enable the shared gRPC mode
-		// terraform.WithProviderRunner(terraform.NewSharedProvider(log, os.Getenv("TERRAFORM_NATIVE_PROVIDER_PATH"), terraform.WithNativeProviderArgs("-debuggable")))
+		Provider:       config.GetProvider(),
 		WorkspaceStore: terraform.NewWorkspaceStore(log),
-		SetupFn:        clients.TerraformSetupBuilder(*terraformVersion, *providerSource, *providerVersion),
+		SetupFn:        clients.TerraformSetupBuilder(*terraformVersion, *providerSource, *providerVersion, scheduler),
 	}
 
 	if *enableExternalSecretStores {
@@ -148,6 +177,10 @@ func main() {
 		log.Info("Beta feature enabled", "flag", features.EnableBetaManagementPolicies)
 	}
 
-	kingpin.FatalIfError(controller.Setup(mgr, o), "Cannot setup Template controllers")
+	if len(*nativeClients) > 0 {
+		log.Info("Reconciling kinds with the MinIO Go SDKs", "kinds", *nativeClients)
+	}
+	kingpin.FatalIfError(controller.SetupWithNativeClients(mgr, o, *nativeClients), "Cannot setup Template controllers")
+	kingpin.FatalIfError(clients.DefaultCredentialsCache.Watch(context.Background(), mgr.GetCache()), "Cannot watch ProviderConfig credentials")
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
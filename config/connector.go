@@ -0,0 +1,68 @@
+package config
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errReadController  = "cannot read generated controller"
+	errWriteController = "cannot write generated controller"
+
+	// generatedConnector is the call that creates the connector of a
+	// generated controller.
+	generatedConnector = "tjcontroller.NewConnector("
+	// customConnector is the function of a controller package that creates
+	// the connector of the controller instead.
+	customConnector = "newConnector("
+)
+
+// customConnectors are the Terraform resources whose controllers get their
+// connector from the newConnector function of their package, which has the
+// signature of tjcontroller.NewConnector. They can be reconciled with the
+// MinIO Go SDKs instead of Terraform, and Buckets are checked for drift that
+// the Terraform provider does not observe.
+var customConnectors = []string{
+	"minio_iam_group",
+	"minio_iam_policy",
+	"minio_s3_bucket",
+	"minio_s3_bucket_versioning",
+}
+
+// ReplaceConnectors makes the generated controllers of the resources with a
+// custom connector in the supplied directory create their connector with
+// newConnector instead of tjcontroller.NewConnector. The generated
+// controllers cannot be given a connector otherwise. It is run after the
+// controllers are generated.
+func ReplaceConnectors(dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || d.Name() != "zz_controller.go" {
+			return err
+		}
+		src, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return errors.Wrapf(err, "%s %s", errReadController, path)
+		}
+		out := replaceConnector(string(src))
+		if out == string(src) {
+			return nil
+		}
+		return errors.Wrapf(os.WriteFile(path, []byte(out), 0o644), "%s %s", errWriteController, path) //nolint:gosec // Generated sources are world readable.
+	})
+}
+
+// replaceConnector returns the supplied generated controller with
+// newConnector in place of tjcontroller.NewConnector if it reconciles a
+// resource with a custom connector.
+func replaceConnector(src string) string {
+	for _, name := range customConnectors {
+		if strings.Contains(src, `o.Provider.Resources["`+name+`"]`) {
+			return strings.Replace(src, generatedConnector, customConnector, 1)
+		}
+	}
+	return src
+}
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const connectorController = `func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(tjcontroller.NewConnector(mgr.GetClient(), o.WorkspaceStore, o.SetupFn, o.Provider.Resources["%s"], tjcontroller.WithLogger(o.Logger),
+			tjcontroller.WithCallbackProvider(ac),
+		)),
+	}
+}
+`
+
+func TestReplaceConnectors(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"bucket/zz_controller.go":    fmt.Sprintf(connectorController, "minio_s3_bucket"),
+		"bucket/native.go":           fmt.Sprintf(connectorController, "minio_s3_bucket"),
+		"accesskey/zz_controller.go": fmt.Sprintf(connectorController, "minio_iam_service_account"),
+	}
+	for name, src := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(src), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ReplaceConnectors(dir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		"bucket/zz_controller.go": `func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(newConnector(mgr.GetClient(), o.WorkspaceStore, o.SetupFn, o.Provider.Resources["minio_s3_bucket"], tjcontroller.WithLogger(o.Logger),
+			tjcontroller.WithCallbackProvider(ac),
+		)),
+	}
+}
+`,
+		// Only the generated controllers are changed.
+		"bucket/native.go": files["bucket/native.go"],
+		// Resources without a custom connector keep the generated one.
+		"accesskey/zz_controller.go": files["accesskey/zz_controller.go"],
+	}
+	for name, src := range want {
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(src, string(got)); diff != "" {
+			t.Errorf("%s: -want, +got:\n%s", name, diff)
+		}
+	}
+}
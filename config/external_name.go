@@ -24,6 +24,29 @@ var ExternalNameConfigs = map[string]config.ExternalName{
 	"minio_s3_bucket_notification": config.TemplatedStringAsIdentifier("bucket", "{{ .external_name }}"),  // uses "bucket" field  
 	"minio_kms_key":                config.TemplatedStringAsIdentifier("key_id", "{{ .external_name }}"),  // uses "key_id" field
 	"minio_iam_service_account":    config.IdentifierFromProvider,  // uses computed "access_key" field
+	"minio_accesskey":              config.IdentifierFromProvider, // uses computed "access_key" field
+
+	// These keep "bucket" in the spec so it can be referenced; the provider
+	// sets the ID to the bucket name.
+	"minio_s3_bucket_replication": config.IdentifierFromProvider,
+	"minio_s3_bucket_retention":   config.IdentifierFromProvider,
+	"minio_s3_bucket_server_side_encryption": config.IdentifierFromProvider,
+
+	// IAM attachments keep their user, group and policy fields in the spec so
+	// they can be referenced; the provider computes the ID.
+	"minio_iam_user_policy_attachment":  config.IdentifierFromProvider,
+	"minio_iam_group_policy_attachment": config.IdentifierFromProvider,
+	"minio_iam_group_policy":            config.IdentifierFromProvider,
+	"minio_iam_group_user_attachment":   config.IdentifierFromProvider,
+	"minio_iam_group_membership":        config.NameAsIdentifier,
+
+	// LDAP attachments are imported with "<dn>/<policy name>" IDs.
+	"minio_iam_ldap_user_policy_attachment":  config.TemplatedStringAsIdentifier("", "{{ .parameters.user_dn }}/{{ .parameters.policy_name }}"),
+	"minio_iam_ldap_group_policy_attachment": config.TemplatedStringAsIdentifier("", "{{ .parameters.group_dn }}/{{ .parameters.policy_name }}"),
+
+	// ILM Resources
+	"minio_ilm_policy": config.IdentifierFromProvider, // ID is the bucket name
+	"minio_ilm_tier":   config.NameAsIdentifier,
 }
 
 // ExternalNameConfigurations applies all external name configs listed in the
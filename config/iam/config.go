@@ -2,6 +2,10 @@ package iam
 
 import "github.com/crossplane/upjet/pkg/config"
 
+// PolicyDocumentJSONExtractor extracts the rendered JSON of a PolicyDocument so
+// that policy fields can reference it.
+const PolicyDocumentJSONExtractor = "github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1.PolicyDocumentJSON()"
+
 // Configure configures individual resources by adding custom ResourceConfigurators.
 func Configure(p *config.Provider) {
 	p.AddResourceConfigurator("minio_iam_user", func(r *config.Resource) {
@@ -12,6 +16,12 @@ func Configure(p *config.Provider) {
 	p.AddResourceConfigurator("minio_iam_policy", func(r *config.Resource) {
 		r.ShortGroup = "iam"
 		r.Kind = "Policy"
+		r.References["policy"] = config.Reference{
+			Type:              "PolicyDocument",
+			Extractor:         PolicyDocumentJSONExtractor,
+			RefFieldName:      "PolicyDocumentRef",
+			SelectorFieldName: "PolicyDocumentSelector",
+		}
 	})
 
 	p.AddResourceConfigurator("minio_iam_group", func(r *config.Resource) {
@@ -23,4 +33,88 @@ func Configure(p *config.Provider) {
 		r.ShortGroup = "iam"
 		r.Kind = "ServiceAccount"
 	})
+	p.AddResourceConfigurator("minio_iam_user_policy_attachment", func(r *config.Resource) {
+		r.ShortGroup = "iam"
+		r.Kind = "UserPolicyAttachment"
+		r.References["user_name"] = config.Reference{
+			TerraformName: "minio_iam_user",
+		}
+		r.References["policy_name"] = config.Reference{
+			TerraformName: "minio_iam_policy",
+		}
+	})
+
+	p.AddResourceConfigurator("minio_iam_group_policy_attachment", func(r *config.Resource) {
+		r.ShortGroup = "iam"
+		r.Kind = "GroupPolicyAttachment"
+		r.References["group_name"] = config.Reference{
+			TerraformName: "minio_iam_group",
+		}
+		r.References["policy_name"] = config.Reference{
+			TerraformName: "minio_iam_policy",
+		}
+	})
+
+	p.AddResourceConfigurator("minio_iam_group_policy", func(r *config.Resource) {
+		r.ShortGroup = "iam"
+		r.Kind = "GroupPolicy"
+		r.References["group"] = config.Reference{
+			TerraformName: "minio_iam_group",
+		}
+	})
+	// GroupMembership is authoritative for the members of a group and removes
+	// any user it does not list, including users added by GroupUserAttachment.
+	// Use one or the other for a given group, not both.
+	p.AddResourceConfigurator("minio_iam_group_membership", func(r *config.Resource) {
+		r.ShortGroup = "iam"
+		r.Kind = "GroupMembership"
+		r.References["group"] = config.Reference{
+			TerraformName: "minio_iam_group",
+		}
+		r.References["users"] = config.Reference{
+			TerraformName: "minio_iam_user",
+		}
+	})
+
+	p.AddResourceConfigurator("minio_iam_group_user_attachment", func(r *config.Resource) {
+		r.ShortGroup = "iam"
+		r.Kind = "GroupUserAttachment"
+		r.References["group_name"] = config.Reference{
+			TerraformName: "minio_iam_group",
+		}
+		r.References["user_name"] = config.Reference{
+			TerraformName: "minio_iam_user",
+		}
+	})
+	p.AddResourceConfigurator("minio_iam_ldap_user_policy_attachment", func(r *config.Resource) {
+		r.ShortGroup = "iam"
+		r.Kind = "LDAPUserPolicyAttachment"
+		r.References["policy_name"] = config.Reference{
+			TerraformName: "minio_iam_policy",
+		}
+	})
+
+	p.AddResourceConfigurator("minio_iam_ldap_group_policy_attachment", func(r *config.Resource) {
+		r.ShortGroup = "iam"
+		r.Kind = "LDAPGroupPolicyAttachment"
+		r.References["policy_name"] = config.Reference{
+			TerraformName: "minio_iam_policy",
+		}
+	})
+	p.AddResourceConfigurator("minio_accesskey", func(r *config.Resource) {
+		r.ShortGroup = "iam"
+		r.Kind = "AccessKey"
+		r.References["user"] = config.Reference{
+			TerraformName: "minio_iam_user",
+		}
+		r.Sensitive.AdditionalConnectionDetailsFn = func(attr map[string]any) (map[string][]byte, error) {
+			conn := map[string][]byte{}
+			for _, k := range []string{"access_key", "secret_key"} {
+				if v, ok := attr[k].(string); ok && v != "" {
+					conn[k] = []byte(v)
+				}
+			}
+			return conn, nil
+		}
+	})
 }
\ No newline at end of file
@@ -0,0 +1,38 @@
+package ilm
+
+import (
+	"github.com/crossplane/upjet/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Configure configures individual resources by adding custom ResourceConfigurators.
+func Configure(p *config.Provider) {
+	p.AddResourceConfigurator("minio_ilm_policy", func(r *config.Resource) {
+		r.ShortGroup = "ilm"
+		r.Kind = "LifecyclePolicy"
+		r.References["bucket"] = config.Reference{
+			TerraformName: "minio_s3_bucket",
+		}
+		// Transitions move objects to a remote tier, which MinIO addresses
+		// by the tier name in storage_class.
+		r.References["rule.transition.storage_class"] = config.Reference{
+			TerraformName: "minio_ilm_tier",
+		}
+		r.References["rule.noncurrent_transition.storage_class"] = config.Reference{
+			TerraformName: "minio_ilm_tier",
+		}
+	})
+
+	p.AddResourceConfigurator("minio_ilm_tier", func(r *config.Resource) {
+		r.ShortGroup = "ilm"
+		r.Kind = "Tier"
+		// bucket is on the remote tier rather than on the MinIO server of the
+		// ProviderConfig, so it does not reference a Bucket.
+		// Access keys of the remote tier are credentials just like the
+		// secret keys, so source them from Secrets as well.
+		for _, block := range []string{"s3_config", "minio_config"} {
+			r.TerraformResource.Schema[block].Elem.(*schema.Resource).
+				Schema["access_key"].Sensitive = true
+		}
+	})
+}
\ No newline at end of file
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errReadTypes  = "cannot read generated types"
+	errWriteTypes = "cannot write generated types"
+
+	// validationMarker is the prefix of the comment lines that add CEL
+	// validation rules to a field.
+	validationMarker = "// +kubebuilder:validation:XValidation"
+)
+
+var observationType = regexp.MustCompile(`^type \w+Observation struct {$`)
+
+// RemoveObservationValidations removes the CEL validation rules from the
+// fields of the generated observation types in the supplied directory. Upjet
+// copies the rules in the description of a Terraform argument to the
+// parameters, init parameters and observation of the argument alike, but
+// status.atProvider reports what the provider observed, such as a quota set
+// outside of Crossplane, which does not have to satisfy them. A status that
+// breaks a rule could not be written at all. It is run after the types are
+// generated.
+func RemoveObservationValidations(dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasPrefix(d.Name(), "zz_") || !strings.HasSuffix(d.Name(), "_types.go") {
+			return err
+		}
+		src, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return errors.Wrapf(err, "%s %s", errReadTypes, path)
+		}
+		out := removeObservationValidations(string(src))
+		if out == string(src) {
+			return nil
+		}
+		return errors.Wrapf(os.WriteFile(path, []byte(out), 0o644), "%s %s", errWriteTypes, path) //nolint:gosec // Generated sources are world readable.
+	})
+}
+
+// removeObservationValidations returns the supplied Go source without the
+// validation markers of the fields of its observation types.
+func removeObservationValidations(src string) string {
+	var b strings.Builder
+	observation := false
+	for _, l := range strings.SplitAfter(src, "\n") {
+		t := strings.TrimSpace(l)
+		switch {
+		case observationType.MatchString(t):
+			observation = true
+		case observation && t == "}":
+			observation = false
+		case observation && strings.HasPrefix(t, validationMarker):
+			continue
+		}
+		b.WriteString(l)
+	}
+	return b.String()
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	rule = `	// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type == 'hard'",message="type must be hard"
+`
+	observedTypes = `type QuotaInitParameters struct {
+	Size *string ` + "`json:\"size,omitempty\"`" + `
+}
+
+type BucketObservation struct {
+
+	// Quota of the bucket
+` + rule + `	Quota *QuotaObservation ` + "`json:\"quota,omitempty\"`" + `
+}
+
+type BucketParameters struct {
+
+	// Quota of the bucket
+` + rule + `	// +kubebuilder:validation:Optional
+	Quota *QuotaParameters ` + "`json:\"quota,omitempty\"`" + `
+}
+`
+)
+
+func TestRemoveObservationValidations(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"zz_bucket_types.go":       observedTypes,
+		"zz_generated.deepcopy.go": observedTypes,
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := RemoveObservationValidations(dir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		"zz_bucket_types.go": `type QuotaInitParameters struct {
+	Size *string ` + "`json:\"size,omitempty\"`" + `
+}
+
+type BucketObservation struct {
+
+	// Quota of the bucket
+	Quota *QuotaObservation ` + "`json:\"quota,omitempty\"`" + `
+}
+
+type BucketParameters struct {
+
+	// Quota of the bucket
+` + rule + `	// +kubebuilder:validation:Optional
+	Quota *QuotaParameters ` + "`json:\"quota,omitempty\"`" + `
+}
+`,
+		// Only the generated types are changed.
+		"zz_generated.deepcopy.go": observedTypes,
+	}
+	for name, src := range want {
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(src, string(got)); diff != "" {
+			t.Errorf("%s: -want, +got:\n%s", name, diff)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	ujconfig "github.com/crossplane/upjet/pkg/config"
 
 	"github.com/markopolo123/provider-upjet-minio/config/iam"
+	"github.com/markopolo123/provider-upjet-minio/config/ilm"
 	"github.com/markopolo123/provider-upjet-minio/config/kms"
 	"github.com/markopolo123/provider-upjet-minio/config/s3"
 )
@@ -32,6 +33,16 @@ func GetProvider() *ujconfig.Provider {
 		ujconfig.WithRootGroup("minio.crossplane.io"),
 		ujconfig.WithIncludeList(ExternalNameConfigured()),
 		ujconfig.WithFeaturesPackage("internal/features"),
+		ujconfig.WithBasePackages(ujconfig.BasePackages{
+			APIVersion: ujconfig.DefaultBasePackages.APIVersion,
+			ControllerMap: map[string]string{
+				"internal/controller/providerconfig": ujconfig.PackageNameConfig,
+				// PolicyDocument and ObjectData are reconciled by
+				// hand-written controllers rather than through Terraform.
+				"internal/controller/iam/policydocument": "iam",
+				"internal/controller/s3/objectdata":      "s3",
+			},
+		}),
 		ujconfig.WithDefaultResourceOptions(
 			ExternalNameConfigurations(),
 		))
@@ -42,6 +53,7 @@ func GetProvider() *ujconfig.Provider {
 		s3.Configure,
 		iam.Configure,
 		kms.Configure,
+		ilm.Configure,
 	} {
 		configure(pc)
 	}
@@ -1,12 +1,18 @@
 package s3
 
-import "github.com/crossplane/upjet/pkg/config"
+import (
+	"github.com/crossplane/upjet/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/markopolo123/provider-upjet-minio/config/iam"
+)
 
 // Configure configures individual resources by adding custom ResourceConfigurators.
 func Configure(p *config.Provider) {
 	p.AddResourceConfigurator("minio_s3_bucket", func(r *config.Resource) {
 		r.ShortGroup = "s3"
 		r.Kind = "Bucket"
+		configureQuota(r)
 	})
 
 	p.AddResourceConfigurator("minio_s3_bucket_policy", func(r *config.Resource) {
@@ -15,6 +21,12 @@ func Configure(p *config.Provider) {
 		r.References["bucket"] = config.Reference{
 			TerraformName: "minio_s3_bucket",
 		}
+		r.References["policy"] = config.Reference{
+			Type:              "github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1.PolicyDocument",
+			Extractor:         iam.PolicyDocumentJSONExtractor,
+			RefFieldName:      "PolicyDocumentRef",
+			SelectorFieldName: "PolicyDocumentSelector",
+		}
 	})
 
 	p.AddResourceConfigurator("minio_s3_object", func(r *config.Resource) {
@@ -40,4 +52,39 @@ func Configure(p *config.Provider) {
 			TerraformName: "minio_s3_bucket",
 		}
 	})
+	p.AddResourceConfigurator("minio_s3_bucket_replication", func(r *config.Resource) {
+		r.ShortGroup = "s3"
+		r.Kind = "BucketReplication"
+		r.References["bucket"] = config.Reference{
+			TerraformName: "minio_s3_bucket",
+		}
+		r.References["rule.target.bucket"] = config.Reference{
+			TerraformName: "minio_s3_bucket",
+		}
+		// The target access key is a credential of the remote site, so source
+		// it from a Secret alongside the already sensitive secret_key.
+		r.TerraformResource.Schema["rule"].Elem.(*schema.Resource).
+			Schema["target"].Elem.(*schema.Resource).
+			Schema["access_key"].Sensitive = true
+	})
+	p.AddResourceConfigurator("minio_s3_bucket_retention", func(r *config.Resource) {
+		r.ShortGroup = "s3"
+		r.Kind = "BucketRetention"
+		r.References["bucket"] = config.Reference{
+			TerraformName: "minio_s3_bucket",
+		}
+		r.TerraformResource.Schema["mode"].Description += "\n+kubebuilder:validation:Enum=GOVERNANCE;COMPLIANCE"
+		r.TerraformResource.Schema["unit"].Description += "\n+kubebuilder:validation:Enum=DAYS;YEARS"
+		r.InitializerFns = append(r.InitializerFns, ObjectLockingInitializer)
+	})
+	p.AddResourceConfigurator("minio_s3_bucket_server_side_encryption", func(r *config.Resource) {
+		r.ShortGroup = "s3"
+		r.Kind = "BucketServerSideEncryption"
+		r.References["bucket"] = config.Reference{
+			TerraformName: "minio_s3_bucket",
+		}
+		r.References["kms_key_id"] = config.Reference{
+			TerraformName: "minio_kms_key",
+		}
+	})
 }
\ No newline at end of file
@@ -0,0 +1,132 @@
+package s3
+
+import (
+	"context"
+	"math"
+
+	"github.com/crossplane/upjet/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	errParseQuota = "cannot parse spec.forProvider.quota.size"
+
+	// quotaArgument is the Terraform argument that holds the quota of a
+	// bucket in bytes.
+	quotaArgument = "quota"
+	// quotaParameter is the key of the structured quota in the parameters
+	// of a Bucket. It is not an argument of the Terraform resource, and is
+	// replaced by quotaArgument before the parameters reach Terraform.
+	quotaParameter = "quota_config"
+)
+
+// configureQuota replaces the quota argument of the Bucket, a number of bytes,
+// with an object of a resource quantity and a quota type. The number that
+// Terraform observes is reported in status.atProvider.quotaBytes, and the
+// live quota and its usage in status.atProvider.quota. The validation rules
+// of the quota only apply to the spec: the generator removes them from the
+// status, which reports a live quota of any type.
+func configureQuota(r *config.Resource) {
+	r.TerraformResource.Schema["quota"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: "Quota of the bucket\n" +
+			"+upjet:crd:field:TFTag=" + quotaParameter + ",omitempty\n" +
+			`+kubebuilder:validation:XValidation:rule="has(self.size) && self.size.matches('^[0-9]+([KMGTPE]i|[kMGTPE])?$')",message="size must be a whole number of bytes or a resource quantity without a fraction, such as 10Gi"` + "\n" +
+			`+kubebuilder:validation:XValidation:rule="!has(self.type) || self.type == 'hard'",message="type must be hard"`,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"size": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Size of the quota as a whole number of bytes or a resource quantity without a fraction, for example 10Gi or 500M",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Type of the quota. MinIO only supports hard quotas, which reject writes that would exceed the size. Defaults to hard",
+				},
+				"usage_percentage": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "Percentage of the quota used by the objects in the bucket, as reported by the admin API",
+				},
+			},
+		},
+	}
+	r.SchemaElementOptions.SetEmbeddedObject("quota")
+	r.TerraformResource.Schema["quota_bytes"] = &schema.Schema{
+		Type:        schema.TypeFloat,
+		Computed:    true,
+		Description: "Quota of the bucket in bytes, as observed by Terraform\n+upjet:crd:field:TFTag=" + quotaArgument + ",omitempty",
+	}
+
+	// SetIdentifierArgumentFn cannot return an error, so a quota that cannot
+	// be converted is left in the arguments, and the error is returned by
+	// GetIDFn. Both are called with the same arguments before the Terraform
+	// configuration of the Bucket is written.
+	setIdentifier := r.ExternalName.SetIdentifierArgumentFn
+	r.ExternalName.SetIdentifierArgumentFn = func(base map[string]any, externalName string) {
+		setIdentifier(base, externalName)
+		_ = quotaToTerraform(base)
+	}
+	getID := r.ExternalName.GetIDFn
+	r.ExternalName.GetIDFn = func(ctx context.Context, externalName string, parameters map[string]any, terraformProviderConfig map[string]any) (string, error) {
+		if err := quotaToTerraform(parameters); err != nil {
+			return "", err
+		}
+		return getID(ctx, externalName, parameters, terraformProviderConfig)
+	}
+}
+
+// quotaToTerraform replaces the structured quota in the supplied Terraform
+// arguments with the number of bytes that the Terraform provider expects. The
+// arguments are left as they are if the size of the quota cannot be parsed.
+func quotaToTerraform(base map[string]any) error {
+	v, ok := base[quotaParameter]
+	if !ok {
+		return nil
+	}
+	q, _ := v.(map[string]any)
+	b, err := parseQuota(q["size"])
+	if err != nil {
+		return err
+	}
+	delete(base, quotaParameter)
+	base[quotaArgument] = b
+	return nil
+}
+
+// parseQuota returns the number of bytes of a quota that is either a number
+// or a resource quantity.
+func parseQuota(v any) (uint64, error) {
+	switch q := v.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		if q < 0 || q > math.MaxInt64 {
+			return 0, errors.Errorf("%s: %v is out of range", errParseQuota, q)
+		}
+		return uint64(q), nil
+	case int64:
+		if q < 0 {
+			return 0, errors.Errorf("%s: %d is out of range", errParseQuota, q)
+		}
+		return uint64(q), nil
+	case string:
+		qty, err := resource.ParseQuantity(q)
+		if err != nil {
+			return 0, errors.Wrap(err, errParseQuota)
+		}
+		b, ok := qty.AsInt64()
+		if !ok || b < 0 {
+			return 0, errors.Errorf("%s: %s is not a whole, positive number of bytes", errParseQuota, q)
+		}
+		return uint64(b), nil
+	default:
+		return 0, errors.Errorf("%s: unexpected type %T", errParseQuota, v)
+	}
+}
@@ -0,0 +1,95 @@
+package s3
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/crossplane/upjet/pkg/config"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestParseQuota(t *testing.T) {
+	tests := []struct {
+		name   string
+		quota  any
+		want   uint64
+		errMsg string
+	}{
+		{name: "Unset", quota: nil},
+		{name: "Number of bytes", quota: float64(1024), want: 1024},
+		{name: "Integer number of bytes", quota: int64(2048), want: 2048},
+		{name: "Binary quantity", quota: "10Gi", want: 10 << 30},
+		{name: "Decimal quantity", quota: "1M", want: 1000000},
+		{name: "Negative", quota: float64(-1), errMsg: "out of range"},
+		{name: "Fraction of a byte", quota: "500m", errMsg: "not a whole, positive number of bytes"},
+		{name: "Invalid quantity", quota: "ten gigs", errMsg: errParseQuota},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseQuota(tt.quota)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("expected error containing %q, got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("want %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestConfigureQuota(t *testing.T) {
+	tests := []struct {
+		name   string
+		params map[string]any
+		want   map[string]any
+		errMsg string
+	}{
+		{
+			name:   "Quantity",
+			params: map[string]any{"quota_config": map[string]any{"size": "10Gi", "type": "hard"}},
+			want:   map[string]any{"quota": uint64(10 << 30)},
+		},
+		{
+			name:   "No quota",
+			params: map[string]any{"acl": "private"},
+			want:   map[string]any{"acl": "private"},
+		},
+		{
+			name:   "Fraction of a byte",
+			params: map[string]any{"quota_config": map[string]any{"size": "1.5"}},
+			errMsg: "not a whole, positive number of bytes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &config.Resource{
+				TerraformResource:    &schema.Resource{Schema: map[string]*schema.Schema{}},
+				ExternalName:         config.IdentifierFromProvider,
+				SchemaElementOptions: config.SchemaElementOptions{},
+			}
+			configureQuota(r)
+			r.ExternalName.SetIdentifierArgumentFn(tt.params, "data")
+			_, err := r.ExternalName.GetIDFn(context.Background(), "data", tt.params, nil)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("expected error containing %q, got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, tt.params); diff != "" {
+				t.Errorf("Terraform arguments: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
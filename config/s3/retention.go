@@ -0,0 +1,100 @@
+package s3
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/upjet/pkg/config"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errGetBucket             = "cannot get referenced Bucket"
+	errListBuckets           = "cannot list Buckets"
+	errObjectLockingDisabled = "bucket %q does not have objectLocking enabled: default retention can only be set on buckets created with objectLocking: true"
+)
+
+// bucketGVK is the GroupVersionKind of the Bucket managed resource. The
+// generated API types cannot be imported here since they are generated from
+// this package.
+var bucketGVK = schema.GroupVersionKind{
+	Group:   "s3.minio.crossplane.io",
+	Version: "v1alpha1",
+	Kind:    "Bucket",
+}
+
+// ObjectLockingInitializer returns an initializer that rejects a resource
+// whose bucket is managed by a Bucket without object locking.
+var ObjectLockingInitializer config.NewInitializerFn = func(kube client.Client) managed.Initializer {
+	return &objectLockingChecker{kube: kube}
+}
+
+// objectLockingChecker verifies that the Bucket a resource points at has
+// object locking enabled before the resource is applied.
+type objectLockingChecker struct {
+	kube client.Client
+}
+
+// Initialize returns an error if the Bucket referenced by or named in
+// spec.forProvider.bucket does not have object locking enabled. Buckets that
+// are not managed by Crossplane cannot be inspected and are left for MinIO to
+// validate.
+func (c *objectLockingChecker) Initialize(ctx context.Context, mg xpresource.Managed) error {
+	if meta.WasDeleted(mg) {
+		// Do not block the deletion of a resource that was rejected.
+		return nil
+	}
+	paved, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return err
+	}
+	b, err := c.getBucket(ctx, paved)
+	if err != nil || b == nil {
+		return err
+	}
+	pb := fieldpath.Pave(b.Object)
+	// Prefer the observed state, and fall back to the desired state for
+	// buckets that have not been observed yet.
+	enabled, err := pb.GetBool("status.atProvider.objectLocking")
+	if err != nil {
+		enabled, _ = pb.GetBool("spec.forProvider.objectLocking")
+	}
+	if !enabled {
+		return errors.Errorf(errObjectLockingDisabled, meta.GetExternalName(b))
+	}
+	return nil
+}
+
+func (c *objectLockingChecker) getBucket(ctx context.Context, paved *fieldpath.Paved) (*unstructured.Unstructured, error) {
+	if ref, err := paved.GetString("spec.forProvider.bucketRef.name"); err == nil && ref != "" {
+		b := &unstructured.Unstructured{}
+		b.SetGroupVersionKind(bucketGVK)
+		if err := c.kube.Get(ctx, types.NamespacedName{Name: ref}, b); err != nil {
+			return nil, errors.Wrap(xpresource.IgnoreNotFound(err), errGetBucket)
+		}
+		return b, nil
+	}
+	name, err := paved.GetString("spec.forProvider.bucket")
+	if err != nil || name == "" {
+		// The bucket has not been resolved from a selector yet.
+		return nil, nil
+	}
+	l := &unstructured.UnstructuredList{}
+	l.SetGroupVersionKind(bucketGVK.GroupVersion().WithKind(bucketGVK.Kind + "List"))
+	if err := c.kube.List(ctx, l); err != nil {
+		return nil, errors.Wrap(err, errListBuckets)
+	}
+	for i := range l.Items {
+		if meta.GetExternalName(&l.Items[i]) == name {
+			return &l.Items[i], nil
+		}
+	}
+	return nil, nil
+}
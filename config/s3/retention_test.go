@@ -0,0 +1,110 @@
+package s3
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1"
+)
+
+func newBucket(name, external string, spec, observed *bool) *v1alpha1.Bucket {
+	b := &v1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	meta.SetExternalName(b, external)
+	b.Spec.ForProvider.ObjectLocking = spec
+	b.Status.AtProvider.ObjectLocking = observed
+	return b
+}
+
+func TestObjectLockingInitializer(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatalf("cannot build scheme: %v", err)
+	}
+
+	now := metav1.Now()
+	tests := []struct {
+		name      string
+		retention *v1alpha1.BucketRetention
+		errMsg    string
+	}{
+		{
+			name: "Referenced bucket with object locking",
+			retention: &v1alpha1.BucketRetention{Spec: v1alpha1.BucketRetentionSpec{ForProvider: v1alpha1.BucketRetentionParameters{
+				BucketRef: &xpv1.Reference{Name: "locked"},
+			}}},
+		},
+		{
+			name: "Referenced bucket without object locking",
+			retention: &v1alpha1.BucketRetention{Spec: v1alpha1.BucketRetentionSpec{ForProvider: v1alpha1.BucketRetentionParameters{
+				BucketRef: &xpv1.Reference{Name: "unlocked"},
+			}}},
+			errMsg: `bucket "unlocked-bucket" does not have objectLocking enabled`,
+		},
+		{
+			name: "Observed state wins over desired state",
+			retention: &v1alpha1.BucketRetention{Spec: v1alpha1.BucketRetentionSpec{ForProvider: v1alpha1.BucketRetentionParameters{
+				BucketRef: &xpv1.Reference{Name: "drifted"},
+			}}},
+			errMsg: `bucket "drifted-bucket" does not have objectLocking enabled`,
+		},
+		{
+			name: "Bucket named by external name",
+			retention: &v1alpha1.BucketRetention{Spec: v1alpha1.BucketRetentionSpec{ForProvider: v1alpha1.BucketRetentionParameters{
+				Bucket: ptr.To("unlocked-bucket"),
+			}}},
+			errMsg: `bucket "unlocked-bucket" does not have objectLocking enabled`,
+		},
+		{
+			name: "Bucket not managed by Crossplane",
+			retention: &v1alpha1.BucketRetention{Spec: v1alpha1.BucketRetentionSpec{ForProvider: v1alpha1.BucketRetentionParameters{
+				Bucket: ptr.To("external-bucket"),
+			}}},
+		},
+		{
+			name:      "Bucket not resolved yet",
+			retention: &v1alpha1.BucketRetention{},
+		},
+		{
+			name: "Deleted resource is not rejected",
+			retention: &v1alpha1.BucketRetention{
+				ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now},
+				Spec: v1alpha1.BucketRetentionSpec{ForProvider: v1alpha1.BucketRetentionParameters{
+					BucketRef: &xpv1.Reference{Name: "unlocked"},
+				}},
+			},
+		},
+	}
+
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		newBucket("locked", "locked-bucket", ptr.To(true), nil),
+		newBucket("unlocked", "unlocked-bucket", ptr.To(false), nil),
+		newBucket("drifted", "drifted-bucket", ptr.To(true), ptr.To(false)),
+	).Build()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ObjectLockingInitializer(kube).Initialize(context.Background(), tt.retention)
+
+			if tt.errMsg != "" {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("expected error to contain '%s' but got: %s", tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
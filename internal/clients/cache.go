@@ -0,0 +1,290 @@
+package clients
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/v1beta1"
+)
+
+const (
+	errGetSecret      = "cannot get credentials secret"
+	errWatchInformer  = "cannot get informer"
+	errAddEventHandle = "cannot add event handler"
+
+	// maxTracked is the number of managed resources whose usage is
+	// remembered per ProviderConfig. Past it the usage of every resource is
+	// tracked again, which is idempotent.
+	maxTracked = 10000
+)
+
+// DefaultCredentialsCache is the cache that ExtractCredentials reads
+// through.
+var DefaultCredentialsCache = NewCredentialsCache()
+
+// credentialsEntry holds the credentials of a ProviderConfig and the managed
+// resources whose usage of it has been tracked.
+type credentialsEntry struct {
+	// version is the generation of the ProviderConfig and the
+	// resourceVersions of the secrets the credentials were read from.
+	version string
+	secrets []types.NamespacedName
+	creds   map[string]string
+	tracked map[types.UID]bool
+}
+
+// A CredentialsCache caches the credentials of ProviderConfigs by UID, so
+// that managed resources do not read and parse the credentials secret and
+// track their ProviderConfig usage on every reconcile. An entry is used only
+// while the generation of the ProviderConfig and the resourceVersions of its
+// secrets are unchanged, and is dropped when a watch reports a change to
+// either. Updates of the status of a ProviderConfig, such as its periodic
+// validation, do not change its generation and keep the entry. Once
+// watching, the resourceVersions of secrets are read from a metadata-only
+// informer, so secrets are only read from the API server when their
+// credentials are not cached.
+type CredentialsCache struct {
+	mu      sync.Mutex
+	entries map[types.UID]*credentialsEntry
+	// metadata reads the metadata of secrets from the informer started by
+	// Watch, if it was called.
+	metadata client.Reader
+
+	hits   prometheus.Counter
+	misses prometheus.Counter
+}
+
+// NewCredentialsCache returns an empty CredentialsCache.
+func NewCredentialsCache() *CredentialsCache {
+	return &CredentialsCache{
+		entries: map[types.UID]*credentialsEntry{},
+		hits: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "provider_minio_credentials_cache_hits_total",
+			Help: "The number of times ProviderConfig credentials were served from the cache.",
+		}),
+		misses: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "provider_minio_credentials_cache_misses_total",
+			Help: "The number of times ProviderConfig credentials were read because they were not cached or had changed.",
+		}),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *CredentialsCache) Describe(ch chan<- *prometheus.Desc) {
+	c.hits.Describe(ch)
+	c.misses.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *CredentialsCache) Collect(ch chan<- prometheus.Metric) {
+	c.hits.Collect(ch)
+	c.misses.Collect(ch)
+}
+
+// Credentials returns the credentials of the supplied ProviderConfig and
+// tracks its usage by the supplied managed resource, reading and tracking
+// only what is not cached. Credentials from the filesystem and injected
+// identities are never cached; the latter cache their STS credentials
+// themselves.
+func (c *CredentialsCache) Credentials(ctx context.Context, kube client.Client, pc *v1beta1.ProviderConfig, mg resource.Managed) (map[string]string, error) {
+	src := pc.Spec.Credentials.Source
+	if src == xpv1.CredentialsSourceFilesystem || src == xpv1.CredentialsSourceInjectedIdentity {
+		if err := trackUsage(ctx, kube, mg); err != nil {
+			return nil, err
+		}
+		return ProviderConfigCredentials(ctx, kube, pc)
+	}
+
+	secrets := credentialsSecrets(pc)
+	version := []string{strconv.FormatInt(pc.GetGeneration(), 10)}
+	for _, nn := range secrets {
+		v, err := c.secretVersion(ctx, kube, nn)
+		if err != nil {
+			return nil, err
+		}
+		version = append(version, v)
+	}
+	v := strings.Join(version, "/")
+
+	c.mu.Lock()
+	e, ok := c.entries[pc.GetUID()]
+	c.mu.Unlock()
+	if ok && e.version == v {
+		c.hits.Inc()
+	} else {
+		c.misses.Inc()
+		creds, err := ProviderConfigCredentials(ctx, kube, pc)
+		if err != nil {
+			return nil, err
+		}
+		e = &credentialsEntry{version: v, secrets: secrets, creds: creds, tracked: map[types.UID]bool{}}
+		c.mu.Lock()
+		c.entries[pc.GetUID()] = e
+		c.mu.Unlock()
+	}
+
+	c.mu.Lock()
+	tracked := e.tracked[mg.GetUID()]
+	c.mu.Unlock()
+	if !tracked {
+		if err := trackUsage(ctx, kube, mg); err != nil {
+			return nil, err
+		}
+	}
+	c.mu.Lock()
+	switch {
+	case meta.WasDeleted(mg):
+		// The usage of a deleted resource is removed along with it.
+		delete(e.tracked, mg.GetUID())
+	case !tracked:
+		if len(e.tracked) >= maxTracked {
+			e.tracked = map[types.UID]bool{}
+		}
+		e.tracked[mg.GetUID()] = true
+	}
+	c.mu.Unlock()
+
+	// Callers may modify the credentials they are given.
+	creds := make(map[string]string, len(e.creds))
+	for k, v := range e.creds {
+		creds[k] = v
+	}
+	return creds, nil
+}
+
+// secretVersion returns the resourceVersion of the named secret, read from
+// the metadata informer once watching and with the supplied client before.
+func (c *CredentialsCache) secretVersion(ctx context.Context, kube client.Reader, nn types.NamespacedName) (string, error) {
+	c.mu.Lock()
+	if c.metadata != nil {
+		kube = c.metadata
+	}
+	c.mu.Unlock()
+	m := secretMetadata()
+	if err := kube.Get(ctx, nn, m); err != nil {
+		return "", errors.Wrap(err, errGetSecret)
+	}
+	return m.GetResourceVersion(), nil
+}
+
+// secretMetadata returns an empty PartialObjectMetadata of a secret.
+func secretMetadata() *metav1.PartialObjectMetadata {
+	m := &metav1.PartialObjectMetadata{}
+	m.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+	return m
+}
+
+// Watch drops cached credentials when the informers of the supplied cache
+// report a change to a ProviderConfig or to a secret its credentials were
+// read from, and the STS credentials and reconcile limits of deleted
+// ProviderConfigs. Secrets are
+// watched with a metadata-only informer, which does not hold their data.
+func (c *CredentialsCache) Watch(ctx context.Context, ca cache.Cache) error {
+	pcs, err := ca.GetInformer(ctx, &v1beta1.ProviderConfig{})
+	if err != nil {
+		return errors.Wrap(err, errWatchInformer)
+	}
+	if _, err := pcs.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		UpdateFunc: c.updateProviderConfig,
+		DeleteFunc: func(obj any) { c.invalidateObject(obj, c.deleteProviderConfig) },
+	}); err != nil {
+		return errors.Wrap(err, errAddEventHandle)
+	}
+
+	secrets, err := ca.GetInformer(ctx, secretMetadata())
+	if err != nil {
+		return errors.Wrap(err, errWatchInformer)
+	}
+	if _, err := secrets.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(_, obj any) { c.invalidateObject(obj, c.invalidateSecret) },
+		DeleteFunc: func(obj any) { c.invalidateObject(obj, c.invalidateSecret) },
+	}); err != nil {
+		return errors.Wrap(err, errAddEventHandle)
+	}
+	c.mu.Lock()
+	c.metadata = ca
+	c.mu.Unlock()
+	return nil
+}
+
+// invalidateObject calls fn with the object an informer event is about.
+func (c *CredentialsCache) invalidateObject(obj any, fn func(client.Object)) {
+	if d, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = d.Obj
+	}
+	if o, ok := obj.(client.Object); ok {
+		fn(o)
+	}
+}
+
+// updateProviderConfig drops the cached credentials of an updated
+// ProviderConfig unless only its metadata or status changed.
+func (c *CredentialsCache) updateProviderConfig(oldObj, obj any) {
+	o, ok := oldObj.(client.Object)
+	n, nok := obj.(client.Object)
+	if ok && nok && o.GetGeneration() == n.GetGeneration() {
+		return
+	}
+	c.invalidateObject(obj, c.invalidateProviderConfig)
+}
+
+func (c *CredentialsCache) invalidateProviderConfig(o client.Object) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, o.GetUID())
+}
+
+// deleteProviderConfig drops the cached credentials of a deleted
+// ProviderConfig, including the STS credentials of an injected identity, and
+// its reconcile limits.
+func (c *CredentialsCache) deleteProviderConfig(o client.Object) {
+	c.invalidateProviderConfig(o)
+	forgetIdentity(string(o.GetUID()))
+	DefaultReconcileLimiter.forget(o)
+}
+
+func (c *CredentialsCache) invalidateSecret(o client.Object) {
+	nn := types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for uid, e := range c.entries {
+		for _, s := range e.secrets {
+			if s == nn {
+				delete(c.entries, uid)
+				break
+			}
+		}
+	}
+}
+
+// credentialsSecrets returns the secrets the credentials of the supplied
+// ProviderConfig are read from.
+func credentialsSecrets(pc *v1beta1.ProviderConfig) []types.NamespacedName {
+	var nns []types.NamespacedName
+	if ref := pc.Spec.Credentials.SecretRef; pc.Spec.Credentials.Source == xpv1.CredentialsSourceSecret && ref != nil {
+		nns = append(nns, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name})
+	}
+	if ref := pc.Spec.CABundleSecretRef; ref != nil {
+		nns = append(nns, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name})
+	}
+	return nns
+}
+
+func trackUsage(ctx context.Context, kube client.Client, mg resource.Managed) error {
+	t := resource.NewProviderConfigUsageTracker(kube, &v1beta1.ProviderConfigUsage{})
+	return errors.Wrap(t.Track(ctx, mg), errTrackUsage)
+}
@@ -0,0 +1,210 @@
+package clients
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1"
+	"github.com/markopolo123/provider-upjet-minio/apis/v1beta1"
+)
+
+func TestCredentialsCache(t *testing.T) {
+	s := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{corev1.AddToScheme, v1beta1.SchemeBuilder.AddToScheme, v1alpha1.SchemeBuilder.AddToScheme} {
+		if err := add(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "upbound-system"},
+		Data:       map[string][]byte{"credentials": []byte(`{"minio_server": "minio:9000", "minio_user": "user", "minio_password": "password"}`)},
+	}
+	pc := &v1beta1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", UID: "pc"},
+		Spec: v1beta1.ProviderConfigSpec{Credentials: v1beta1.ProviderCredentials{
+			Source: xpv1.CredentialsSourceSecret,
+			CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "creds", Namespace: "upbound-system"}, Key: "credentials",
+			}},
+		}},
+	}
+	mg := &v1alpha1.Bucket{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.CRDGroupVersion.String(), Kind: v1alpha1.Bucket_Kind},
+		ObjectMeta: metav1.ObjectMeta{Name: "bucket", UID: "bucket"},
+	}
+	mg.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(secret, pc).Build()
+	ctx := context.Background()
+	c := NewCredentialsCache()
+
+	// credentials reads the credentials through the cache and reports whether
+	// the usage of the ProviderConfig was tracked.
+	credentials := func() (map[string]string, bool) {
+		t.Helper()
+		if err := kube.Get(ctx, types.NamespacedName{Name: "default"}, pc); err != nil {
+			t.Fatal(err)
+		}
+		creds, err := c.Credentials(ctx, kube, pc, mg)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		pcu := &v1beta1.ProviderConfigUsage{}
+		err = kube.Get(ctx, types.NamespacedName{Name: "bucket"}, pcu)
+		if err != nil && !kerrors.IsNotFound(err) {
+			t.Fatal(err)
+		}
+		if err == nil {
+			if err := kube.Delete(ctx, pcu); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return creds, err == nil
+	}
+
+	tests := []struct {
+		name    string
+		change  func()
+		user    string
+		tracked bool
+		hits    float64
+		misses  float64
+	}{
+		{
+			name:    "First read is a miss",
+			change:  func() {},
+			user:    "user",
+			tracked: true,
+			misses:  1,
+		},
+		{
+			name:   "Unchanged credentials are a hit",
+			change: func() {},
+			user:   "user",
+			hits:   1,
+			misses: 1,
+		},
+		{
+			name: "Secret update is a miss",
+			change: func() {
+				secret.Data["credentials"] = []byte(`{"minio_server": "minio:9000", "minio_user": "rotated", "minio_password": "password"}`)
+				if err := kube.Update(ctx, secret); err != nil {
+					t.Fatal(err)
+				}
+			},
+			user:    "rotated",
+			tracked: true,
+			hits:    1,
+			misses:  2,
+		},
+		{
+			name:   "Watched secret update drops the entry",
+			change: func() { c.invalidateSecret(secret) },
+			user:   "rotated",
+			// The usage is tracked again after an entry is dropped.
+			tracked: true,
+			hits:    1,
+			misses:  3,
+		},
+		{
+			name:    "Watched ProviderConfig update drops the entry",
+			change:  func() { c.invalidateProviderConfig(pc) },
+			user:    "rotated",
+			tracked: true,
+			hits:    1,
+			misses:  4,
+		},
+		{
+			name: "ProviderConfig status update is a hit",
+			change: func() {
+				old := pc.DeepCopy()
+				pc.Status.LastValidatedTime = &metav1.Time{Time: metav1.Now().Time}
+				if err := kube.Update(ctx, pc); err != nil {
+					t.Fatal(err)
+				}
+				c.updateProviderConfig(old, pc)
+			},
+			user:   "rotated",
+			hits:   2,
+			misses: 4,
+		},
+		{
+			name: "ProviderConfig spec update is a miss",
+			change: func() {
+				old := pc.DeepCopy()
+				region := "eu-west-1"
+				pc.Spec.Region = &region
+				pc.SetGeneration(pc.GetGeneration() + 1)
+				if err := kube.Update(ctx, pc); err != nil {
+					t.Fatal(err)
+				}
+				c.updateProviderConfig(old, pc)
+			},
+			user:    "rotated",
+			tracked: true,
+			hits:    2,
+			misses:  5,
+		},
+		{
+			name: "Secret versions are read from the informer once watching",
+			change: func() {
+				// The informer has not seen the update yet.
+				c.metadata = fake.NewClientBuilder().WithScheme(s).WithObjects(secret.DeepCopy()).Build()
+				secret.Data["credentials"] = []byte(`{"minio_server": "minio:9000", "minio_user": "unseen", "minio_password": "password"}`)
+				if err := kube.Update(ctx, secret); err != nil {
+					t.Fatal(err)
+				}
+			},
+			user:   "rotated",
+			hits:   3,
+			misses: 5,
+		},
+		{
+			name: "Deleted resources are no longer remembered as tracked",
+			change: func() {
+				now := metav1.Now()
+				mg.SetDeletionTimestamp(&now)
+			},
+			user:   "rotated",
+			hits:   4,
+			misses: 5,
+		},
+		{
+			name: "Usage of a resource that was forgotten is tracked again",
+			change: func() {
+				mg.SetDeletionTimestamp(nil)
+			},
+			user:    "rotated",
+			tracked: true,
+			hits:    5,
+			misses:  5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.change()
+			creds, tracked := credentials()
+			if creds["minio_user"] != tt.user {
+				t.Errorf("minio_user = %q, want %q", creds["minio_user"], tt.user)
+			}
+			if tracked != tt.tracked {
+				t.Errorf("tracked = %t, want %t", tracked, tt.tracked)
+			}
+			if got := testutil.ToFloat64(c.hits); got != tt.hits {
+				t.Errorf("hits = %v, want %v", got, tt.hits)
+			}
+			if got := testutil.ToFloat64(c.misses); got != tt.misses {
+				t.Errorf("misses = %v, want %v", got, tt.misses)
+			}
+		})
+	}
+}
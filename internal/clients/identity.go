@@ -0,0 +1,112 @@
+package clients
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/v1beta1"
+)
+
+const (
+	errNoServer         = "server is required for source InjectedIdentity"
+	errReadIdentityFile = "cannot read service account token"
+	errAssumeRole       = "cannot assume role with web identity"
+
+	// defaultTokenPath is where Kubernetes mounts the service account token
+	// of the provider pod.
+	defaultTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+)
+
+// webIdentity holds the STS credentials of a ProviderConfig together with
+// the settings they were requested with.
+type webIdentity struct {
+	key   string
+	creds *credentials.Credentials
+}
+
+var (
+	identitiesMu sync.Mutex
+	// identities caches STS credentials by ProviderConfig UID. The
+	// credentials refresh themselves shortly before they expire. Entries
+	// are removed when their ProviderConfig is deleted.
+	identities = map[string]*webIdentity{}
+)
+
+// forgetIdentity removes the cached STS credentials of the ProviderConfig
+// with the supplied UID.
+func forgetIdentity(uid string) {
+	identitiesMu.Lock()
+	defer identitiesMu.Unlock()
+	delete(identities, uid)
+}
+
+// injectIdentity exchanges the service account token of the provider pod for
+// temporary credentials with the STS AssumeRoleWithWebIdentity API of the
+// MinIO server in creds.
+func injectIdentity(pc *v1beta1.ProviderConfig, creds map[string]string) error {
+	if creds["minio_server"] == "" {
+		return errors.New(errNoServer)
+	}
+	useSSL, t, err := transport(creds)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		t = http.DefaultTransport
+	}
+
+	wi := pc.Spec.Credentials.WebIdentity
+	if wi == nil {
+		wi = &v1beta1.WebIdentity{}
+	}
+	tokenPath := ptr.Deref(wi.TokenPath, defaultTokenPath)
+	endpoint := "http://" + creds["minio_server"]
+	if useSSL {
+		endpoint = "https://" + creds["minio_server"]
+	}
+	key := strings.Join([]string{
+		endpoint, tokenPath, ptr.Deref(wi.RoleARN, ""),
+		strconv.FormatInt(ptr.Deref(wi.DurationSeconds, 0), 10),
+		creds["minio_insecure"], creds["minio_cacert"], creds["minio_cert"],
+	}, "\n")
+
+	identitiesMu.Lock()
+	id, ok := identities[string(pc.GetUID())]
+	if !ok || id.key != key {
+		id = &webIdentity{key: key, creds: credentials.New(&credentials.STSWebIdentity{
+			Client:      &http.Client{Transport: t},
+			STSEndpoint: endpoint,
+			RoleARN:     ptr.Deref(wi.RoleARN, ""),
+			GetWebIDTokenExpiry: func() (*credentials.WebIdentityToken, error) {
+				// The token is read on every refresh because the kubelet
+				// rotates it.
+				token, err := os.ReadFile(tokenPath) // #nosec G304 -- path is set by the cluster operator
+				if err != nil {
+					return nil, errors.Wrap(err, errReadIdentityFile)
+				}
+				return &credentials.WebIdentityToken{
+					Token:  strings.TrimSpace(string(token)),
+					Expiry: int(ptr.Deref(wi.DurationSeconds, 0)),
+				}, nil
+			},
+		})}
+		identities[string(pc.GetUID())] = id
+	}
+	identitiesMu.Unlock()
+
+	v, err := id.creds.Get()
+	if err != nil {
+		return errors.Wrap(err, errAssumeRole)
+	}
+	creds["minio_user"] = v.AccessKeyID
+	creds["minio_password"] = v.SecretAccessKey
+	creds["minio_session_token"] = v.SessionToken
+	return nil
+}
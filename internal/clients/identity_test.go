@@ -0,0 +1,139 @@
+package clients
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/v1beta1"
+)
+
+// newSTSServer returns a server that issues credentials valid for the
+// supplied duration to callers presenting token, counting the calls made.
+func newSTSServer(token string, valid time.Duration, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(calls, 1)
+		w.Header().Set("Content-Type", "application/xml")
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("Action") != "AssumeRoleWithWebIdentity" || r.PostForm.Get("WebIdentityToken") != token {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`<ErrorResponse><Error><Code>InvalidIdentityToken</Code><Message>Invalid token</Message></Error></ErrorResponse>`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><AssumeRoleWithWebIdentityResult><Credentials>`+
+			`<AccessKeyId>ASIA%d</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken>`+
+			`<Expiration>%s</Expiration></Credentials></AssumeRoleWithWebIdentityResult></AssumeRoleWithWebIdentityResponse>`,
+			n, time.Now().Add(valid).UTC().Format(time.RFC3339))
+	}))
+}
+
+func TestInjectIdentity(t *testing.T) {
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenPath, []byte("jwt\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		valid     time.Duration
+		tokenPath string
+		server    bool
+		// deleted is whether the ProviderConfig is deleted and recreated
+		// between the two requests.
+		deleted bool
+		calls   int32
+		errMsg  string
+	}{
+		{
+			name:      "Credentials are cached until they expire",
+			valid:     time.Hour,
+			tokenPath: tokenPath,
+			server:    true,
+			calls:     1,
+		},
+		{
+			name:      "Expired credentials are refreshed",
+			valid:     -time.Minute,
+			tokenPath: tokenPath,
+			server:    true,
+			calls:     2,
+		},
+		{
+			name:      "Credentials of a deleted ProviderConfig are dropped",
+			valid:     time.Hour,
+			tokenPath: tokenPath,
+			server:    true,
+			deleted:   true,
+			calls:     2,
+		},
+		{
+			name:      "Missing token",
+			valid:     time.Hour,
+			tokenPath: filepath.Join(t.TempDir(), "missing"),
+			server:    true,
+			errMsg:    errReadIdentityFile,
+		},
+		{
+			name:   "Missing server",
+			errMsg: errNoServer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			srv := newSTSServer("jwt", tt.valid, &calls)
+			defer srv.Close()
+
+			pc := &v1beta1.ProviderConfig{
+				ObjectMeta: metav1.ObjectMeta{UID: types.UID(tt.name)},
+				Spec: v1beta1.ProviderConfigSpec{Credentials: v1beta1.ProviderCredentials{
+					Source:      xpv1.CredentialsSourceInjectedIdentity,
+					WebIdentity: &v1beta1.WebIdentity{TokenPath: ptr.To(tt.tokenPath)},
+				}},
+			}
+			var err error
+			creds := map[string]string{}
+			for i := 0; i < 2 && err == nil; i++ {
+				if i > 0 && tt.deleted {
+					NewCredentialsCache().deleteProviderConfig(pc)
+				}
+				creds = map[string]string{}
+				if tt.server {
+					creds["minio_server"] = strings.TrimPrefix(srv.URL, "http://")
+				}
+				err = injectIdentity(pc, creds)
+			}
+
+			if tt.errMsg != "" {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("expected error to contain '%s' but got: %s", tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := atomic.LoadInt32(&calls); got != tt.calls {
+				t.Errorf("STS calls = %d, want %d", got, tt.calls)
+			}
+			want := fmt.Sprintf("ASIA%d", tt.calls)
+			if creds["minio_user"] != want || creds["minio_password"] != "secret" || creds["minio_session_token"] != "token" {
+				t.Errorf("injectIdentity() = %v, want user %s", creds, want)
+			}
+		})
+	}
+}
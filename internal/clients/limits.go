@@ -0,0 +1,193 @@
+package clients
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/v1beta1"
+)
+
+const (
+	errReconcileLimit = "ProviderConfig %q is at its reconcile limit, retrying later"
+
+	// defaultMaxWait is how long a reconcile waits for the limits of its
+	// ProviderConfig before it is retried with a backoff. It is kept short
+	// so that the reconciles of other ProviderConfigs are not held up by the
+	// workers that wait.
+	defaultMaxWait = time.Second
+)
+
+// DefaultReconcileLimiter is the limiter that connectors admit reconciles
+// with.
+var DefaultReconcileLimiter = NewReconcileLimiter(defaultMaxWait)
+
+// limitsEntry holds the token bucket and the concurrency slots of a
+// ProviderConfig. A nil bucket or slots means that there is no limit.
+type limitsEntry struct {
+	limits v1beta1.ReconcileLimits
+	bucket *rate.Limiter
+	slots  chan struct{}
+}
+
+// A ReconcileLimiter enforces the ReconcileLimits of ProviderConfigs, and
+// reports the reconciles that wait for them, run or are deferred for every
+// ProviderConfig. Entries are keyed by the UID of the ProviderConfig, are
+// replaced when its limits change, and are dropped when it is deleted.
+type ReconcileLimiter struct {
+	mu      sync.Mutex
+	entries map[types.UID]*limitsEntry
+	maxWait time.Duration
+
+	waiting  *prometheus.GaugeVec
+	inFlight *prometheus.GaugeVec
+	deferred *prometheus.CounterVec
+}
+
+// NewReconcileLimiter returns a ReconcileLimiter that lets reconciles wait up
+// to maxWait for the limits of their ProviderConfig.
+func NewReconcileLimiter(maxWait time.Duration) *ReconcileLimiter {
+	return &ReconcileLimiter{
+		entries: map[types.UID]*limitsEntry{},
+		maxWait: maxWait,
+		waiting: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "provider_minio_providerconfig_reconciles_waiting",
+			Help: "The number of reconciles that are waiting for the reconcile limits of a ProviderConfig.",
+		}, []string{"providerconfig"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "provider_minio_providerconfig_reconciles_in_flight",
+			Help: "The number of reconciles that are running for a ProviderConfig with a maxConcurrentReconciles limit.",
+		}, []string{"providerconfig"}),
+		deferred: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "provider_minio_providerconfig_reconciles_deferred_total",
+			Help: "The number of reconciles that were retried later because a ProviderConfig was at its reconcile limits.",
+		}, []string{"providerconfig"}),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (l *ReconcileLimiter) Describe(ch chan<- *prometheus.Desc) {
+	l.waiting.Describe(ch)
+	l.inFlight.Describe(ch)
+	l.deferred.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (l *ReconcileLimiter) Collect(ch chan<- prometheus.Metric) {
+	l.waiting.Collect(ch)
+	l.inFlight.Collect(ch)
+	l.deferred.Collect(ch)
+}
+
+// Admit waits until a reconcile is allowed by the ReconcileLimits of the
+// supplied ProviderConfig, or returns an error if that takes longer than the
+// maximum wait. The error is a conflict, which the managed reconciler
+// requeues with a backoff without setting an error condition or recording an
+// event, so that a throttled resource stays Synced.
+//
+// The concurrency slot taken by the reconcile is released when the supplied
+// context is done, which the managed reconciler does when the reconcile
+// returns. Terraform operations that the reconcile started asynchronously
+// keep running after that, so MaxConcurrentReconciles limits the reconciles
+// rather than the Terraform operations of a ProviderConfig.
+func (l *ReconcileLimiter) Admit(ctx context.Context, pc *v1beta1.ProviderConfig) error {
+	e := l.entry(pc)
+	if e.bucket == nil && e.slots == nil {
+		return nil
+	}
+	name := pc.GetName()
+
+	l.waiting.WithLabelValues(name).Inc()
+	wctx, cancel := context.WithTimeout(ctx, l.maxWait)
+	err := e.wait(wctx)
+	cancel()
+	l.waiting.WithLabelValues(name).Dec()
+	if err != nil {
+		l.deferred.WithLabelValues(name).Inc()
+		return kerrors.NewConflict(v1beta1.SchemeGroupVersion.WithResource("providerconfigs").GroupResource(), name, errors.Errorf(errReconcileLimit, name))
+	}
+
+	if e.slots != nil {
+		l.inFlight.WithLabelValues(name).Inc()
+		context.AfterFunc(ctx, func() {
+			l.inFlight.WithLabelValues(name).Dec()
+			<-e.slots
+		})
+	}
+	return nil
+}
+
+// entry returns the entry for the current limits of the supplied
+// ProviderConfig.
+func (l *ReconcileLimiter) entry(pc *v1beta1.ProviderConfig) *limitsEntry {
+	limits := v1beta1.ReconcileLimits{}
+	if pc.Spec.ReconcileLimits != nil {
+		limits = *pc.Spec.ReconcileLimits.DeepCopy()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if e, ok := l.entries[pc.GetUID()]; ok && sameLimits(e.limits, limits) {
+		return e
+	}
+	e := &limitsEntry{limits: limits}
+	if r := limits.RatePerSecond; r != nil {
+		burst := *r
+		if limits.Burst != nil {
+			burst = *limits.Burst
+		}
+		e.bucket = rate.NewLimiter(rate.Limit(*r), int(burst))
+	}
+	if c := limits.MaxConcurrentReconciles; c != nil {
+		e.slots = make(chan struct{}, *c)
+	}
+	l.entries[pc.GetUID()] = e
+	return e
+}
+
+// forget drops the entry and the metrics of a deleted ProviderConfig.
+// Reconciles that were admitted before keep their concurrency slots until
+// they return.
+func (l *ReconcileLimiter) forget(pc client.Object) {
+	l.mu.Lock()
+	delete(l.entries, pc.GetUID())
+	l.mu.Unlock()
+	l.waiting.DeleteLabelValues(pc.GetName())
+	l.inFlight.DeleteLabelValues(pc.GetName())
+	l.deferred.DeleteLabelValues(pc.GetName())
+}
+
+// wait takes a concurrency slot and a token from the bucket of the entry,
+// or neither if the context is done first.
+func (e *limitsEntry) wait(ctx context.Context) error {
+	if e.slots != nil {
+		select {
+		case e.slots <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if e.bucket != nil {
+		if err := e.bucket.Wait(ctx); err != nil {
+			if e.slots != nil {
+				<-e.slots
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func sameLimits(a, b v1beta1.ReconcileLimits) bool {
+	eq := func(x, y *int64) bool {
+		return (x == nil && y == nil) || (x != nil && y != nil && *x == *y)
+	}
+	return eq(a.RatePerSecond, b.RatePerSecond) && eq(a.Burst, b.Burst) && eq(a.MaxConcurrentReconciles, b.MaxConcurrentReconciles)
+}
@@ -0,0 +1,147 @@
+package clients
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/v1beta1"
+)
+
+func newLimitedProviderConfig(name string, limits *v1beta1.ReconcileLimits) *v1beta1.ProviderConfig {
+	return &v1beta1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID(name)},
+		Spec:       v1beta1.ProviderConfigSpec{ReconcileLimits: limits},
+	}
+}
+
+func TestReconcileLimiter(t *testing.T) {
+	tests := []struct {
+		name string
+		// admit admits reconciles with the supplied limiter and returns the
+		// ProviderConfig whose metrics are checked.
+		admit    func(t *testing.T, l *ReconcileLimiter) string
+		inFlight float64
+		deferred float64
+	}{
+		{
+			name: "Unlimited",
+			admit: func(t *testing.T, l *ReconcileLimiter) string {
+				pc := newLimitedProviderConfig("default", nil)
+				for i := 0; i < 100; i++ {
+					if err := l.Admit(context.Background(), pc); err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+				}
+				return "default"
+			},
+		},
+		{
+			name: "Rate limit",
+			admit: func(t *testing.T, l *ReconcileLimiter) string {
+				pc := newLimitedProviderConfig("noisy", &v1beta1.ReconcileLimits{RatePerSecond: ptr.To[int64](1), Burst: ptr.To[int64](2)})
+				for i := 0; i < 2; i++ {
+					if err := l.Admit(context.Background(), pc); err != nil {
+						t.Fatalf("reconcile %d within the burst: unexpected error: %v", i, err)
+					}
+				}
+				err := l.Admit(context.Background(), pc)
+				if err == nil || !strings.Contains(err.Error(), `"noisy" is at its reconcile limit`) {
+					t.Errorf("reconcile over the burst: want a reconcile limit error, got %v", err)
+				}
+				// Conflicts are requeued without an error condition.
+				if !kerrors.IsConflict(errors.Wrap(err, "cannot connect")) {
+					t.Errorf("reconcile over the burst: want a conflict, got %v", err)
+				}
+				// Other ProviderConfigs are not limited.
+				if err := l.Admit(context.Background(), newLimitedProviderConfig("quiet", nil)); err != nil {
+					t.Errorf("reconcile of another ProviderConfig: unexpected error: %v", err)
+				}
+				return "noisy"
+			},
+			deferred: 1,
+		},
+		{
+			name: "Concurrency limit",
+			admit: func(t *testing.T, l *ReconcileLimiter) string {
+				pc := newLimitedProviderConfig("noisy", &v1beta1.ReconcileLimits{MaxConcurrentReconciles: ptr.To[int64](1)})
+				first, done := context.WithCancel(context.Background())
+				if err := l.Admit(first, pc); err != nil {
+					t.Fatalf("first reconcile: unexpected error: %v", err)
+				}
+				if err := l.Admit(context.Background(), pc); err == nil {
+					t.Errorf("concurrent reconcile: want a reconcile limit error, got none")
+				}
+				done()
+				second, cancel := context.WithCancel(context.Background())
+				t.Cleanup(cancel)
+				if err := l.Admit(second, pc); err != nil {
+					t.Errorf("reconcile after the first returned: unexpected error: %v", err)
+				}
+				return "noisy"
+			},
+			inFlight: 1,
+			deferred: 1,
+		},
+		{
+			name: "Changed limits",
+			admit: func(t *testing.T, l *ReconcileLimiter) string {
+				pc := newLimitedProviderConfig("noisy", &v1beta1.ReconcileLimits{RatePerSecond: ptr.To[int64](1)})
+				if err := l.Admit(context.Background(), pc); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				pc.Spec.ReconcileLimits = nil
+				if err := l.Admit(context.Background(), pc); err != nil {
+					t.Errorf("reconcile after the limits were removed: unexpected error: %v", err)
+				}
+				return "noisy"
+			},
+		},
+		{
+			name: "Deleted ProviderConfig",
+			admit: func(t *testing.T, l *ReconcileLimiter) string {
+				pc := newLimitedProviderConfig("noisy", &v1beta1.ReconcileLimits{RatePerSecond: ptr.To[int64](1)})
+				if err := l.Admit(context.Background(), pc); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if err := l.Admit(context.Background(), pc); err == nil {
+					t.Fatalf("reconcile over the burst: want a reconcile limit error, got none")
+				}
+				l.forget(pc)
+				if _, ok := l.entries[pc.GetUID()]; ok {
+					t.Errorf("the limits of a deleted ProviderConfig were kept")
+				}
+				// A ProviderConfig that is created again with the same
+				// name starts with new limits.
+				pc.SetUID("noisy-again")
+				if err := l.Admit(context.Background(), pc); err != nil {
+					t.Errorf("reconcile of a recreated ProviderConfig: unexpected error: %v", err)
+				}
+				return "noisy"
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewReconcileLimiter(50 * time.Millisecond)
+			pc := tt.admit(t, l)
+			if got := testutil.ToFloat64(l.waiting.WithLabelValues(pc)); got != 0 {
+				t.Errorf("waiting: want 0, got %v", got)
+			}
+			if got := testutil.ToFloat64(l.inFlight.WithLabelValues(pc)); got != tt.inFlight {
+				t.Errorf("in flight: want %v, got %v", tt.inFlight, got)
+			}
+			if got := testutil.ToFloat64(l.deferred.WithLabelValues(pc)); got != tt.deferred {
+				t.Errorf("deferred: want %v, got %v", tt.deferred, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package clients
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"strconv"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pkg/errors"
+)
+
+const (
+	errParseSSL       = "cannot parse minio_ssl"
+	errParseInsecure  = "cannot parse minio_insecure"
+	errNewClient      = "cannot create MinIO client"
+	errNewAdminClient = "cannot create MinIO admin client"
+	errParseCACert    = "cannot parse minio_cacert: no PEM certificates found"
+	errLoadCertPair   = "cannot load minio_cert and minio_key"
+)
+
+// NewMinioClient returns an S3 client for the MinIO server described by the
+// supplied credentials. It is used by the controllers that talk to MinIO
+// directly instead of through Terraform.
+func NewMinioClient(creds map[string]string) (*minio.Client, error) {
+	useSSL, t, err := transport(creds)
+	if err != nil {
+		return nil, err
+	}
+	o := &minio.Options{
+		Creds:  credentials.NewStaticV4(creds["minio_user"], creds["minio_password"], creds["minio_session_token"]),
+		Secure: useSSL,
+		Region: creds["minio_region"],
+	}
+	if t != nil {
+		o.Transport = t
+	}
+	c, err := minio.New(creds["minio_server"], o)
+	return c, errors.Wrap(err, errNewClient)
+}
+
+// NewAdminClient returns a client of the admin API of the MinIO server
+// described by the supplied credentials.
+func NewAdminClient(creds map[string]string) (*madmin.AdminClient, error) {
+	useSSL, t, err := transport(creds)
+	if err != nil {
+		return nil, err
+	}
+	o := &madmin.Options{
+		Creds:  credentials.NewStaticV4(creds["minio_user"], creds["minio_password"], creds["minio_session_token"]),
+		Secure: useSSL,
+	}
+	if t != nil {
+		o.Transport = t
+	}
+	c, err := madmin.NewWithOptions(creds["minio_server"], o)
+	return c, errors.Wrap(err, errNewAdminClient)
+}
+
+// transport returns whether the supplied credentials use TLS, and the
+// transport for their TLS settings or nil if the defaults apply.
+func transport(creds map[string]string) (bool, http.RoundTripper, error) {
+	useSSL, err := parseBool(creds["minio_ssl"])
+	if err != nil {
+		return false, nil, errors.Wrap(err, errParseSSL)
+	}
+	insecure, err := parseBool(creds["minio_insecure"])
+	if err != nil {
+		return false, nil, errors.Wrap(err, errParseInsecure)
+	}
+	if !useSSL {
+		return false, nil, nil
+	}
+	tc, err := TLSConfig(creds, insecure)
+	if err != nil || tc == nil {
+		return true, nil, err
+	}
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.TLSClientConfig = tc
+	return true, t, nil
+}
+
+// TLSConfig returns the TLS configuration for the CA bundle and client
+// certificate in the supplied credentials, or nil if the defaults apply.
+func TLSConfig(creds map[string]string, insecure bool) (*tls.Config, error) {
+	if !insecure && creds["minio_cacert"] == "" && creds["minio_cert"] == "" {
+		return nil, nil
+	}
+	tc := &tls.Config{InsecureSkipVerify: insecure} // #nosec G402 -- explicitly requested by minio_insecure
+	if ca := creds["minio_cacert"]; ca != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(ca)) {
+			return nil, errors.New(errParseCACert)
+		}
+		tc.RootCAs = pool
+	}
+	if cert := creds["minio_cert"]; cert != "" {
+		pair, err := tls.X509KeyPair([]byte(cert), []byte(creds["minio_key"]))
+		if err != nil {
+			return nil, errors.Wrap(err, errLoadCertPair)
+		}
+		tc.Certificates = []tls.Certificate{pair}
+	}
+	return tc, nil
+}
+
+func parseBool(s string) (bool, error) {
+	if s == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(s)
+}
@@ -5,9 +5,17 @@ Copyright 2021 Upbound Inc.
 package clients
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
@@ -25,11 +33,58 @@ const (
 	errTrackUsage           = "cannot track ProviderConfig usage"
 	errExtractCredentials   = "cannot extract credentials"
 	errUnmarshalCredentials = "cannot unmarshal minio credentials as JSON"
+	errParseBool            = "cannot parse %s as a boolean"
+	errWriteFile            = "cannot write %s"
+	errGetCABundle          = "cannot get CA bundle"
+	errMissingCredentials   = "missing required credentials for mode %s: %s"
+	errUnknownMode          = "unknown credentials mode %q"
 )
 
+// stringAttributes are the credentials keys that are passed to the Terraform
+// provider as they are.
+var stringAttributes = []string{
+	"minio_server",
+	"minio_user",
+	"minio_password",
+	"minio_region",
+	"minio_api_version",
+	"minio_access_key",
+	"minio_secret_key",
+	"minio_session_token",
+}
+
+// boolAttributes are the credentials keys that are passed to the Terraform
+// provider as booleans.
+var boolAttributes = []string{
+	"minio_ssl",
+	"minio_insecure",
+}
+
+// fileAttributes are the credentials keys holding PEM material that the
+// Terraform provider only accepts as a file path. The material is written to
+// the named file in the workspace, and the provider attribute points at it.
+var fileAttributes = []struct {
+	key       string
+	attribute string
+	file      string
+}{
+	{key: "minio_cacert", attribute: "minio_cacert_file", file: "minio-ca.pem"},
+	{key: "minio_cert", attribute: "minio_cert_file", file: "minio-cert.pem"},
+	{key: "minio_key", attribute: "minio_key_file", file: "minio-key.pem"},
+}
+
+// credentialsModeKeys are the credentials keys required by each credentials
+// mode.
+var credentialsModeKeys = map[v1beta1.CredentialsMode][]string{
+	v1beta1.CredentialsModeStatic:       {"minio_user", "minio_password"},
+	v1beta1.CredentialsModeAccessKey:    {"minio_access_key", "minio_secret_key"},
+	v1beta1.CredentialsModeSessionToken: {"minio_access_key", "minio_secret_key", "minio_session_token"},
+}
+
 // TerraformSetupBuilder builds Terraform a terraform.SetupFn function which
 // returns Terraform provider setup configuration
-func TerraformSetupBuilder(version, providerSource, providerVersion string) terraform.SetupFn {
+func TerraformSetupBuilder(version, providerSource, providerVersion string, scheduler terraform.ProviderScheduler) terraform.SetupFn {
+	_, shared := scheduler.(*terraform.SharedProviderScheduler)
 	return func(ctx context.Context, client client.Client, mg resource.Managed) (terraform.Setup, error) {
 		ps := terraform.Setup{
 			Version: version,
@@ -37,38 +92,223 @@ func TerraformSetupBuilder(version, providerSource, providerVersion string) terr
 				Source:  providerSource,
 				Version: providerVersion,
 			},
+			Scheduler: scheduler,
 		}
 
-		configRef := mg.GetProviderConfigReference()
-		if configRef == nil {
-			return ps, errors.New(errNoProviderConfig)
+		creds, err := ConnectCredentials(ctx, client, mg)
+		if err != nil {
+			return ps, err
 		}
-		pc := &v1beta1.ProviderConfig{}
-		if err := client.Get(ctx, types.NamespacedName{Name: configRef.Name}, pc); err != nil {
-			return ps, errors.Wrap(err, errGetProviderConfig)
+
+		// Set credentials in Terraform provider configuration. Files are
+		// written to the workspace directory of the resource so that they are
+		// removed along with it. Shared provider processes are picked by
+		// their configuration, so with those the files are written to a
+		// directory named after their content instead, which is the same
+		// for all resources of a ProviderConfig.
+		dir := filepath.Join(os.TempDir(), string(mg.GetUID()))
+		if shared {
+			dir = filepath.Join(os.TempDir(), "minio-tls", tlsDigest(creds))
 		}
+		ps.Configuration, err = providerConfiguration(creds, dir)
+		return ps, err
+	}
+}
+
+// tlsDigest returns a digest of the PEM material in the supplied credentials.
+func tlsDigest(creds map[string]string) string {
+	h := sha256.New()
+	for _, a := range fileAttributes {
+		_, _ = h.Write([]byte(creds[a.key]))
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
 
-		t := resource.NewProviderConfigUsageTracker(client, &v1beta1.ProviderConfigUsage{})
-		if err := t.Track(ctx, mg); err != nil {
-			return ps, errors.Wrap(err, errTrackUsage)
+// providerConfiguration returns the Terraform provider configuration for the
+// supplied credentials. Only the attributes that are set are included so that
+// the provider defaults apply to the rest.
+func providerConfiguration(creds map[string]string, dir string) (map[string]any, error) {
+	cfg := map[string]any{}
+	for _, k := range stringAttributes {
+		if v := creds[k]; v != "" {
+			cfg[k] = v
 		}
+	}
+	for _, k := range boolAttributes {
+		if creds[k] == "" {
+			continue
+		}
+		v, err := parseBool(creds[k])
+		if err != nil {
+			return nil, errors.Wrapf(err, errParseBool, k)
+		}
+		cfg[k] = v
+	}
+	for _, f := range fileAttributes {
+		if pem := creds[f.key]; pem != "" {
+			path := filepath.Join(dir, f.file)
+			if err := writeFile(path, []byte(pem)); err != nil {
+				return nil, errors.Wrapf(err, errWriteFile, f.key)
+			}
+			cfg[f.attribute] = path
+			continue
+		}
+		// Paths are passed as they are, e.g. for files mounted into the
+		// provider pod.
+		if path := creds[f.attribute]; path != "" {
+			cfg[f.attribute] = path
+		}
+	}
+	return cfg, nil
+}
+
+// writeFile writes data to path unless the file already has that content, so
+// that a running Terraform operation does not see the file change.
+func writeFile(path string, data []byte) error {
+	if cur, err := os.ReadFile(filepath.Clean(path)); err == nil && bytes.Equal(cur, data) {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// ExtractCredentials returns the credentials of the ProviderConfig referenced
+// by the supplied managed resource and tracks its usage. Both are cached in
+// DefaultCredentialsCache. It does not admit a reconcile, so connectors use
+// ConnectCredentials instead. It is used where the reconcile was already
+// admitted, such as by the Bucket drift check after its inner connector, and
+// outside of reconciles, such as by the BucketUsageCollector, which reads the
+// usage of a ProviderConfig once per interval.
+func ExtractCredentials(ctx context.Context, client client.Client, mg resource.Managed) (map[string]string, error) {
+	pc, err := providerConfig(ctx, client, mg)
+	if err != nil {
+		return nil, err
+	}
+	return DefaultCredentialsCache.Credentials(ctx, client, pc, mg)
+}
+
+// ConnectCredentials returns the credentials of the ProviderConfig referenced
+// by the supplied managed resource like ExtractCredentials, once the
+// reconcile of the resource is admitted by the ReconcileLimits of the
+// ProviderConfig. It is called by the connectors of managed reconcilers,
+// whose context is done when the reconcile returns.
+func ConnectCredentials(ctx context.Context, client client.Client, mg resource.Managed) (map[string]string, error) {
+	pc, err := providerConfig(ctx, client, mg)
+	if err != nil {
+		return nil, err
+	}
+	if err := DefaultReconcileLimiter.Admit(ctx, pc); err != nil {
+		return nil, err
+	}
+	return DefaultCredentialsCache.Credentials(ctx, client, pc, mg)
+}
 
+// providerConfig returns the ProviderConfig referenced by the supplied
+// managed resource.
+func providerConfig(ctx context.Context, client client.Client, mg resource.Managed) (*v1beta1.ProviderConfig, error) {
+	configRef := mg.GetProviderConfigReference()
+	if configRef == nil {
+		return nil, errors.New(errNoProviderConfig)
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := client.Get(ctx, types.NamespacedName{Name: configRef.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetProviderConfig)
+	}
+	return pc, nil
+}
+
+// ProviderConfigCredentials returns the credentials of the supplied
+// ProviderConfig, with the settings in its spec taking precedence over the
+// same settings in the credentials.
+func ProviderConfigCredentials(ctx context.Context, client client.Client, pc *v1beta1.ProviderConfig) (map[string]string, error) {
+	creds := map[string]string{}
+	// Injected identities are exchanged for credentials once the endpoint
+	// is known.
+	if pc.Spec.Credentials.Source != xpv1.CredentialsSourceInjectedIdentity {
 		data, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, client, pc.Spec.Credentials.CommonCredentialSelectors)
 		if err != nil {
-			return ps, errors.Wrap(err, errExtractCredentials)
+			return nil, errors.Wrap(err, errExtractCredentials)
 		}
-		creds := map[string]string{}
-		if err := json.Unmarshal(data, &creds); err != nil {
-			return ps, errors.Wrap(err, errUnmarshalCredentials)
+		// Credentials may be omitted entirely, e.g. with source None.
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &creds); err != nil {
+				return nil, errors.Wrap(err, errUnmarshalCredentials)
+			}
 		}
+		if err := applyCredentialsMode(pc.Spec.Credentials.Mode, creds); err != nil {
+			return nil, err
+		}
+	}
 
-		// Set credentials in Terraform provider configuration.
-		ps.Configuration = map[string]any{
-			"minio_server":   creds["minio_server"],
-			"minio_user":     creds["minio_user"],
-			"minio_password": creds["minio_password"],
-			"minio_region":   creds["minio_region"],
+	s := pc.Spec
+	if s.Server != nil {
+		creds["minio_server"] = *s.Server
+	}
+	if s.SSL != nil {
+		creds["minio_ssl"] = strconv.FormatBool(*s.SSL)
+	}
+	if s.Insecure != nil {
+		creds["minio_insecure"] = strconv.FormatBool(*s.Insecure)
+	}
+	if s.Region != nil {
+		creds["minio_region"] = *s.Region
+	}
+	if s.APIVersion != nil {
+		creds["minio_api_version"] = *s.APIVersion
+	}
+	if ref := s.CABundleSecretRef; ref != nil {
+		ca, err := resource.ExtractSecret(ctx, client, xpv1.CommonCredentialSelectors{SecretRef: ref})
+		if err != nil {
+			return nil, errors.Wrap(err, errGetCABundle)
+		}
+		creds["minio_cacert"] = string(ca)
+	}
+	if pc.Spec.Credentials.Source == xpv1.CredentialsSourceInjectedIdentity {
+		if err := injectIdentity(pc, creds); err != nil {
+			return nil, err
+		}
+	}
+	return creds, nil
+}
+
+// applyCredentialsMode checks that the credentials have the keys required by
+// the mode, and rewrites them in terms of minio_user, minio_password and
+// minio_session_token, which are the keys used from here on.
+func applyCredentialsMode(mode v1beta1.CredentialsMode, creds map[string]string) error {
+	if mode == "" {
+		mode = v1beta1.CredentialsModeStatic
+	}
+	keys, ok := credentialsModeKeys[mode]
+	if !ok {
+		return errors.Errorf(errUnknownMode, mode)
+	}
+	var missing []string
+	for _, k := range keys {
+		if creds[k] == "" {
+			missing = append(missing, k)
 		}
-		return ps, nil
 	}
+	if len(missing) > 0 {
+		return errors.Errorf(errMissingCredentials, mode, strings.Join(missing, ", "))
+	}
+
+	switch mode {
+	case v1beta1.CredentialsModeStatic:
+		// Static credentials never carry a session token.
+		delete(creds, "minio_session_token")
+	case v1beta1.CredentialsModeAccessKey:
+		delete(creds, "minio_session_token")
+		fallthrough
+	case v1beta1.CredentialsModeSessionToken:
+		// minio_access_key and minio_secret_key are deprecated in the
+		// Terraform provider in favor of minio_user and minio_password.
+		creds["minio_user"] = creds["minio_access_key"]
+		creds["minio_password"] = creds["minio_secret_key"]
+	}
+	delete(creds, "minio_access_key")
+	delete(creds, "minio_secret_key")
+	return nil
 }
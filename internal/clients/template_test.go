@@ -0,0 +1,268 @@
+package clients
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/v1beta1"
+)
+
+func TestProviderConfiguration(t *testing.T) {
+	tests := []struct {
+		name   string
+		creds  map[string]string
+		want   map[string]any
+		files  map[string]string
+		errMsg string
+	}{
+		{
+			name: "Only set attributes are forwarded",
+			creds: map[string]string{
+				"minio_server":   "minio:9000",
+				"minio_user":     "user",
+				"minio_password": "password",
+				"minio_region":   "",
+			},
+			want: map[string]any{
+				"minio_server":   "minio:9000",
+				"minio_user":     "user",
+				"minio_password": "password",
+			},
+		},
+		{
+			name: "All string and bool attributes are forwarded",
+			creds: map[string]string{
+				"minio_server":        "minio:9000",
+				"minio_user":          "user",
+				"minio_password":      "password",
+				"minio_region":        "eu-west-1",
+				"minio_api_version":   "v4",
+				"minio_session_token": "token",
+				"minio_ssl":           "true",
+				"minio_insecure":      "false",
+			},
+			want: map[string]any{
+				"minio_server":        "minio:9000",
+				"minio_user":          "user",
+				"minio_password":      "password",
+				"minio_region":        "eu-west-1",
+				"minio_api_version":   "v4",
+				"minio_session_token": "token",
+				"minio_ssl":           true,
+				"minio_insecure":      false,
+			},
+		},
+		{
+			name: "PEM material is written to the workspace",
+			creds: map[string]string{
+				"minio_server": "minio:9000",
+				"minio_ssl":    "true",
+				"minio_cacert": "ca",
+				"minio_cert":   "cert",
+				"minio_key":    "key",
+			},
+			want: map[string]any{
+				"minio_server":      "minio:9000",
+				"minio_ssl":         true,
+				"minio_cacert_file": "minio-ca.pem",
+				"minio_cert_file":   "minio-cert.pem",
+				"minio_key_file":    "minio-key.pem",
+			},
+			files: map[string]string{
+				"minio-ca.pem":   "ca",
+				"minio-cert.pem": "cert",
+				"minio-key.pem":  "key",
+			},
+		},
+		{
+			name: "File paths are forwarded",
+			creds: map[string]string{
+				"minio_server":      "minio:9000",
+				"minio_cacert_file": "/etc/minio/ca.pem",
+			},
+			want: map[string]any{
+				"minio_server":      "minio:9000",
+				"minio_cacert_file": "/etc/minio/ca.pem",
+			},
+		},
+		{
+			name: "Invalid boolean",
+			creds: map[string]string{
+				"minio_server": "minio:9000",
+				"minio_ssl":    "yes please",
+			},
+			errMsg: "cannot parse minio_ssl as a boolean",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "workspace")
+			got, err := providerConfiguration(tt.creds, dir)
+
+			if tt.errMsg != "" {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("expected error to contain '%s' but got: %s", tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// Files are expected relative to the workspace directory.
+			for f := range tt.files {
+				for k, v := range tt.want {
+					if v == f {
+						tt.want[k] = filepath.Join(dir, f)
+					}
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("providerConfiguration() = %v, want %v", got, tt.want)
+			}
+			for f, content := range tt.files {
+				b, err := os.ReadFile(filepath.Join(dir, f))
+				if err != nil {
+					t.Fatalf("cannot read %s: %v", f, err)
+				}
+				if string(b) != content {
+					t.Errorf("%s = %q, want %q", f, string(b), content)
+				}
+			}
+		})
+	}
+}
+
+func TestProviderConfigCredentials(t *testing.T) {
+	kube := fake.NewClientBuilder().WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "upbound-system"},
+			Data:       map[string][]byte{"credentials": []byte(`{"minio_server": "old:9000", "minio_user": "user", "minio_password": "password", "minio_ssl": "false"}`)},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "accesskey", Namespace: "upbound-system"},
+			Data:       map[string][]byte{"credentials": []byte(`{"minio_server": "minio:9000", "minio_access_key": "AKIA", "minio_secret_key": "secret"}`)},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "sts", Namespace: "upbound-system"},
+			Data:       map[string][]byte{"credentials": []byte(`{"minio_server": "minio:9000", "minio_access_key": "ASIA", "minio_secret_key": "secret", "minio_session_token": "token"}`)},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "upbound-system"},
+			Data:       map[string][]byte{"ca.crt": []byte("ca")},
+		},
+	).Build()
+	secretRef := func(name, key string) *xpv1.SecretKeySelector {
+		return &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: name, Namespace: "upbound-system"}, Key: key}
+	}
+
+	tests := []struct {
+		name   string
+		spec   v1beta1.ProviderConfigSpec
+		want   map[string]string
+		errMsg string
+	}{
+		{
+			name: "Credentials only",
+			spec: v1beta1.ProviderConfigSpec{
+				Credentials: v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret, CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: secretRef("creds", "credentials")}},
+			},
+			want: map[string]string{"minio_server": "old:9000", "minio_user": "user", "minio_password": "password", "minio_ssl": "false"},
+		},
+		{
+			name: "Spec fields win over credentials",
+			spec: v1beta1.ProviderConfigSpec{
+				Credentials:       v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret, CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: secretRef("creds", "credentials")}},
+				Server:            ptr.To("new:443"),
+				SSL:               ptr.To(true),
+				Insecure:          ptr.To(false),
+				Region:            ptr.To("eu-west-1"),
+				APIVersion:        ptr.To("v4"),
+				CABundleSecretRef: secretRef("ca", "ca.crt"),
+			},
+			want: map[string]string{
+				"minio_server":      "new:443",
+				"minio_user":        "user",
+				"minio_password":    "password",
+				"minio_ssl":         "true",
+				"minio_insecure":    "false",
+				"minio_region":      "eu-west-1",
+				"minio_api_version": "v4",
+				"minio_cacert":      "ca",
+			},
+		},
+		{
+			name: "No credentials",
+			spec: v1beta1.ProviderConfigSpec{
+				Credentials: v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceNone},
+				Server:      ptr.To("minio:9000"),
+			},
+			errMsg: "missing required credentials for mode Static: minio_user, minio_password",
+		},
+		{
+			name: "Access key mode",
+			spec: v1beta1.ProviderConfigSpec{
+				Credentials: v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret, Mode: v1beta1.CredentialsModeAccessKey, CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: secretRef("accesskey", "credentials")}},
+			},
+			want: map[string]string{"minio_server": "minio:9000", "minio_user": "AKIA", "minio_password": "secret"},
+		},
+		{
+			name: "Session token mode",
+			spec: v1beta1.ProviderConfigSpec{
+				Credentials: v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret, Mode: v1beta1.CredentialsModeSessionToken, CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: secretRef("sts", "credentials")}},
+			},
+			want: map[string]string{"minio_server": "minio:9000", "minio_user": "ASIA", "minio_password": "secret", "minio_session_token": "token"},
+		},
+		{
+			name: "Session token mode without a token",
+			spec: v1beta1.ProviderConfigSpec{
+				Credentials: v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret, Mode: v1beta1.CredentialsModeSessionToken, CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: secretRef("accesskey", "credentials")}},
+			},
+			errMsg: "missing required credentials for mode SessionToken: minio_session_token",
+		},
+		{
+			name: "Missing CA bundle secret",
+			spec: v1beta1.ProviderConfigSpec{
+				Credentials:       v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret, CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: secretRef("creds", "credentials")}},
+				CABundleSecretRef: secretRef("missing", "ca.crt"),
+			},
+			errMsg: errGetCABundle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pc := &v1beta1.ProviderConfig{Spec: tt.spec}
+			got, err := ProviderConfigCredentials(context.Background(), kube, pc)
+
+			if tt.errMsg != "" {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("expected error to contain '%s' but got: %s", tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ProviderConfigCredentials() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package clients
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/minio/madmin-go/v3"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1"
+)
+
+const (
+	errListBuckets  = "cannot list Buckets"
+	errGetDataUsage = "cannot get data usage"
+)
+
+// DefaultDataUsageCache is the cache that controllers read the data usage of
+// MinIO servers from.
+var DefaultDataUsageCache = NewDataUsageCache(time.Minute)
+
+var (
+	bucketLabels = []string{"bucket", "providerconfig"}
+
+	bucketSizeDesc = prometheus.NewDesc("minio_bucket_size_bytes",
+		"The total size of the objects in a bucket, as reported by the MinIO admin API.", bucketLabels, nil)
+	bucketObjectsDesc = prometheus.NewDesc("minio_bucket_objects",
+		"The number of objects in a bucket, as reported by the MinIO admin API.", bucketLabels, nil)
+	bucketQuotaDesc = prometheus.NewDesc("minio_bucket_quota_utilization_ratio",
+		"The size of a bucket divided by its quota. Buckets without a quota are not reported.", bucketLabels, nil)
+)
+
+// bucketUsage is the usage of a bucket.
+type bucketUsage struct {
+	size    uint64
+	objects uint64
+}
+
+// A bucketUsageFn returns the usage of the named buckets on the MinIO server
+// of the ProviderConfig of mg. Buckets that the server does not report are
+// omitted.
+type bucketUsageFn func(ctx context.Context, kube client.Client, mg resource.Managed, names []string) (map[string]bucketUsage, error)
+
+// bucketSample is the usage of a bucket of a ProviderConfig. A quota of 0
+// means that the bucket has no quota.
+type bucketSample struct {
+	bucket         string
+	providerConfig string
+	quota          uint64
+	bucketUsage
+}
+
+// A BucketUsageCollector periodically reads the data usage of every Ready
+// Bucket from the admin API of its MinIO server, and reports it as
+// Prometheus metrics labelled by bucket and ProviderConfig. The usage is read
+// once per ProviderConfig for every interval, rather than for every scrape of
+// the metrics, and through a DataUsageCache that the drift check of Buckets
+// shares. Quotas are read from the status of the Buckets.
+type BucketUsageCollector struct {
+	kube     client.Client
+	log      logging.Logger
+	interval time.Duration
+	usage    bucketUsageFn
+
+	mu      sync.Mutex
+	samples []bucketSample
+}
+
+// NewBucketUsageCollector returns a BucketUsageCollector that reads the
+// Buckets with the supplied client every interval, and the usage of their
+// servers through the supplied cache.
+func NewBucketUsageCollector(kube client.Client, log logging.Logger, interval time.Duration, cache *DataUsageCache) *BucketUsageCollector {
+	return &BucketUsageCollector{kube: kube, log: log, interval: interval, usage: liveBucketUsage(cache)}
+}
+
+// Describe implements prometheus.Collector.
+func (c *BucketUsageCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- bucketSizeDesc
+	ch <- bucketObjectsDesc
+	ch <- bucketQuotaDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *BucketUsageCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, s := range c.samples {
+		ch <- prometheus.MustNewConstMetric(bucketSizeDesc, prometheus.GaugeValue, float64(s.size), s.bucket, s.providerConfig)
+		ch <- prometheus.MustNewConstMetric(bucketObjectsDesc, prometheus.GaugeValue, float64(s.objects), s.bucket, s.providerConfig)
+		if s.quota > 0 {
+			ch <- prometheus.MustNewConstMetric(bucketQuotaDesc, prometheus.GaugeValue, float64(s.size)/float64(s.quota), s.bucket, s.providerConfig)
+		}
+	}
+}
+
+// Start reads the usage of the Buckets every interval until the supplied
+// context is done. It implements manager.Runnable.
+func (c *BucketUsageCollector) Start(ctx context.Context) error {
+	t := time.NewTicker(c.interval)
+	defer t.Stop()
+	for {
+		if err := c.update(ctx); err != nil {
+			c.log.Info("Cannot update bucket usage metrics", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// update replaces the reported usage with the usage of the Ready Buckets.
+// The buckets of a ProviderConfig whose usage cannot be read are no longer
+// reported, and the error is logged.
+func (c *BucketUsageCollector) update(ctx context.Context) error {
+	l := &v1alpha1.BucketList{}
+	if err := c.kube.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListBuckets)
+	}
+
+	type group struct {
+		mg     resource.Managed
+		names  []string
+		quotas map[string]uint64
+	}
+	groups := map[string]*group{}
+	var order []string
+	for i := range l.Items {
+		b := &l.Items[i]
+		ref := b.GetProviderConfigReference()
+		name := meta.GetExternalName(b)
+		if ref == nil || name == "" || b.GetCondition(xpv1.TypeReady).Status != corev1.ConditionTrue {
+			continue
+		}
+		g, ok := groups[ref.Name]
+		if !ok {
+			g = &group{mg: b, quotas: map[string]uint64{}}
+			groups[ref.Name] = g
+			order = append(order, ref.Name)
+		}
+		g.names = append(g.names, name)
+		if q := b.Status.AtProvider.QuotaBytes; q != nil && *q > 0 {
+			g.quotas[name] = uint64(*q)
+		}
+	}
+
+	var samples []bucketSample
+	for _, pc := range order {
+		g := groups[pc]
+		usage, err := c.usage(ctx, c.kube, g.mg, g.names)
+		if err != nil {
+			c.log.Info("Cannot read the usage of Buckets", "providerconfig", pc, "error", err)
+			continue
+		}
+		for _, name := range g.names {
+			if u, ok := usage[name]; ok {
+				samples = append(samples, bucketSample{bucket: name, providerConfig: pc, quota: g.quotas[name], bucketUsage: u})
+			}
+		}
+	}
+
+	c.mu.Lock()
+	c.samples = samples
+	c.mu.Unlock()
+	return nil
+}
+
+// liveBucketUsage returns a bucketUsageFn that reads the usage of buckets
+// from the admin API of the MinIO server of the ProviderConfig of mg, through
+// the supplied cache.
+func liveBucketUsage(cache *DataUsageCache) bucketUsageFn {
+	return func(ctx context.Context, kube client.Client, mg resource.Managed, names []string) (map[string]bucketUsage, error) {
+		creds, err := ExtractCredentials(ctx, kube, mg)
+		if err != nil {
+			return nil, err
+		}
+		admin, err := NewAdminClient(creds)
+		if err != nil {
+			return nil, err
+		}
+		info, err := cache.DataUsage(ctx, creds["minio_server"], admin.DataUsageInfo)
+		if err != nil {
+			return nil, err
+		}
+		usage := make(map[string]bucketUsage, len(names))
+		for _, name := range names {
+			if u, ok := info.BucketsUsage[name]; ok {
+				usage[name] = bucketUsage{size: u.Size, objects: u.ObjectsCount}
+			}
+		}
+		return usage, nil
+	}
+}
+
+// A DataUsageFn reads the data usage of a MinIO server from its admin API.
+type DataUsageFn func(ctx context.Context) (madmin.DataUsageInfo, error)
+
+type dataUsageEntry struct {
+	info    madmin.DataUsageInfo
+	expires time.Time
+}
+
+// A DataUsageCache keeps the data usage of MinIO servers for a TTL. The
+// admin API computes the usage of every bucket of a server at once, so the
+// controllers of the buckets on a server share it rather than reading it on
+// every reconcile. The usage of a server is read again once it expires.
+type DataUsageCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]dataUsageEntry
+	now     func() time.Time
+}
+
+// NewDataUsageCache returns a DataUsageCache that keeps the usage of a server
+// for the supplied TTL.
+func NewDataUsageCache(ttl time.Duration) *DataUsageCache {
+	return &DataUsageCache{ttl: ttl, entries: map[string]dataUsageEntry{}, now: time.Now}
+}
+
+// DataUsage returns the data usage of the supplied server, reading it with
+// read if it is not cached or has expired. Errors are not cached.
+func (c *DataUsageCache) DataUsage(ctx context.Context, server string, read DataUsageFn) (madmin.DataUsageInfo, error) {
+	c.mu.Lock()
+	now := c.now()
+	for s, e := range c.entries {
+		if !now.Before(e.expires) {
+			delete(c.entries, s)
+		}
+	}
+	e, ok := c.entries[server]
+	c.mu.Unlock()
+	if ok {
+		return e.info, nil
+	}
+
+	info, err := read(ctx)
+	if err != nil {
+		return madmin.DataUsageInfo{}, errors.Wrap(err, errGetDataUsage)
+	}
+	c.mu.Lock()
+	c.entries[server] = dataUsageEntry{info: info, expires: now.Add(c.ttl)}
+	c.mu.Unlock()
+	return info, nil
+}
@@ -0,0 +1,144 @@
+package clients
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/minio/madmin-go/v3"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1"
+)
+
+func newBucket(name, providerConfig string, ready bool, quota float64) *v1alpha1.Bucket {
+	b := &v1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if quota > 0 {
+		b.Status.AtProvider.QuotaBytes = &quota
+	}
+	meta.SetExternalName(b, name)
+	b.SetProviderConfigReference(&xpv1.Reference{Name: providerConfig})
+	if ready {
+		b.SetConditions(xpv1.Available())
+	} else {
+		b.SetConditions(xpv1.Creating())
+	}
+	return b
+}
+
+func TestBucketUsageCollector(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		newBucket("data", "default", true, 4<<30),
+		newBucket("logs", "default", true, 0),
+		newBucket("pending", "default", false, 0),
+		newBucket("tenant", "tenant", true, 0),
+		newBucket("broken", "broken", true, 0),
+	).Build()
+
+	var calls []string
+	c := NewBucketUsageCollector(kube, logging.NewNopLogger(), 0, NewDataUsageCache(time.Minute))
+	c.usage = func(_ context.Context, _ client.Client, mg resource.Managed, names []string) (map[string]bucketUsage, error) {
+		pc := mg.GetProviderConfigReference().Name
+		calls = append(calls, pc+":"+strings.Join(names, ","))
+		switch pc {
+		case "default":
+			return map[string]bucketUsage{
+				"data": {size: 1 << 30, objects: 10},
+				"logs": {size: 2048, objects: 2},
+			}, nil
+		case "tenant":
+			// The bucket was deleted since the Bucket was last observed.
+			return map[string]bucketUsage{}, nil
+		default:
+			return nil, errors.New("boom")
+		}
+	}
+	if err := c.update(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := strings.Join(calls, " "), "broken:broken default:data,logs tenant:tenant"; got != want {
+		t.Errorf("usage calls: want %q, got %q", want, got)
+	}
+	want := `
+# HELP minio_bucket_objects The number of objects in a bucket, as reported by the MinIO admin API.
+# TYPE minio_bucket_objects gauge
+minio_bucket_objects{bucket="data",providerconfig="default"} 10
+minio_bucket_objects{bucket="logs",providerconfig="default"} 2
+# HELP minio_bucket_quota_utilization_ratio The size of a bucket divided by its quota. Buckets without a quota are not reported.
+# TYPE minio_bucket_quota_utilization_ratio gauge
+minio_bucket_quota_utilization_ratio{bucket="data",providerconfig="default"} 0.25
+# HELP minio_bucket_size_bytes The total size of the objects in a bucket, as reported by the MinIO admin API.
+# TYPE minio_bucket_size_bytes gauge
+minio_bucket_size_bytes{bucket="data",providerconfig="default"} 1.073741824e+09
+minio_bucket_size_bytes{bucket="logs",providerconfig="default"} 2048
+`
+	if err := testutil.CollectAndCompare(c, strings.NewReader(want)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDataUsageCache(t *testing.T) {
+	now := time.Now()
+	c := NewDataUsageCache(time.Minute)
+	c.now = func() time.Time { return now }
+
+	reads := map[string]int{}
+	read := func(server string, err error) DataUsageFn {
+		return func(_ context.Context) (madmin.DataUsageInfo, error) {
+			reads[server]++
+			return madmin.DataUsageInfo{ObjectsTotalCount: uint64(reads[server])}, err
+		}
+	}
+	get := func(server string, err error) uint64 {
+		t.Helper()
+		info, gerr := c.DataUsage(context.Background(), server, read(server, err))
+		if err != nil {
+			if gerr == nil || !strings.Contains(gerr.Error(), errGetDataUsage) {
+				t.Errorf("%s: want a data usage error, got %v", server, gerr)
+			}
+			return 0
+		}
+		if gerr != nil {
+			t.Fatalf("%s: unexpected error: %v", server, gerr)
+		}
+		return info.ObjectsTotalCount
+	}
+
+	if got := get("a:9000", nil); got != 1 {
+		t.Errorf("first read: want 1, got %d", got)
+	}
+	if got := get("a:9000", nil); got != 1 {
+		t.Errorf("cached read: want 1, got %d", got)
+	}
+	if got := get("b:9000", nil); got != 1 {
+		t.Errorf("read of another server: want 1, got %d", got)
+	}
+	get("c:9000", errors.New("boom"))
+	get("c:9000", errors.New("boom"))
+
+	now = now.Add(time.Minute)
+	if got := get("a:9000", nil); got != 2 {
+		t.Errorf("read after the TTL: want 2, got %d", got)
+	}
+	if _, ok := c.entries["b:9000"]; ok {
+		t.Errorf("expired entry of b:9000 was not evicted")
+	}
+	if reads["c:9000"] != 2 {
+		t.Errorf("errors: want 2 reads, got %d", reads["c:9000"])
+	}
+}
@@ -0,0 +1,122 @@
+package group
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/upjet/pkg/config"
+	tjcontroller "github.com/crossplane/upjet/pkg/controller"
+	"github.com/crossplane/upjet/pkg/terraform"
+	"github.com/minio/madmin-go/v3"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1"
+	"github.com/markopolo123/provider-upjet-minio/internal/controller/native"
+)
+
+const (
+	errNotGroup       = "managed resource is not a Group"
+	errGetGroup       = "cannot get group"
+	errCreateGroup    = "cannot create group"
+	errSetGroupStatus = "cannot set group status"
+	errDeleteGroup    = "cannot delete group"
+	errGroupHasUsers  = "group has members; set forceDestroy to delete it anyway"
+
+	codeNoSuchGroup = "XMinioAdminNoSuchGroup"
+)
+
+// newConnector returns the connector of the Group controller, which
+// reconciles Groups with the MinIO Go SDKs if the kind is reconciled
+// natively, and with Terraform otherwise.
+func newConnector(kube client.Client, ws *terraform.WorkspaceStore, sf terraform.SetupFn, cfg *config.Resource, opts ...tjcontroller.Option) managed.ExternalConnecter {
+	return native.NewConnector(kube, v1alpha1.Group_GroupVersionKind, tjcontroller.NewConnector(kube, ws, sf, cfg, opts...), func(c native.Clients) managed.ExternalClient {
+		return &nativeExternal{admin: c.Admin}
+	})
+}
+
+// nativeExternal manages the group named by the external name.
+type nativeExternal struct {
+	admin *madmin.AdminClient
+}
+
+func (e *nativeExternal) Observe(ctx context.Context, mg xpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotGroup)
+	}
+	name := meta.GetExternalName(cr)
+	desc, err := e.admin.GetGroupDescription(ctx, name)
+	if madmin.ToErrorResponse(err).Code == codeNoSuchGroup {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetGroup)
+	}
+
+	disabled := desc.Status == string(madmin.GroupDisabled)
+	cr.Status.AtProvider = v1alpha1.GroupObservation{
+		DisableGroup: ptr.To(disabled),
+		ForceDestroy: cr.Spec.ForProvider.ForceDestroy,
+		GroupName:    ptr.To(name),
+		ID:           ptr.To(name),
+	}
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: disabled == ptr.Deref(cr.Spec.ForProvider.DisableGroup, false),
+	}, nil
+}
+
+func (e *nativeExternal) Create(ctx context.Context, mg xpresource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotGroup)
+	}
+	if err := e.admin.UpdateGroupMembers(ctx, madmin.GroupAddRemove{Group: meta.GetExternalName(cr), Members: []string{}}); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateGroup)
+	}
+	_, err := e.Update(ctx, mg)
+	return managed.ExternalCreation{}, err
+}
+
+func (e *nativeExternal) Update(ctx context.Context, mg xpresource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotGroup)
+	}
+	status := madmin.GroupEnabled
+	if ptr.Deref(cr.Spec.ForProvider.DisableGroup, false) {
+		status = madmin.GroupDisabled
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(e.admin.SetGroupStatus(ctx, meta.GetExternalName(cr), status), errSetGroupStatus)
+}
+
+func (e *nativeExternal) Delete(ctx context.Context, mg xpresource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return errors.New(errNotGroup)
+	}
+	name := meta.GetExternalName(cr)
+	desc, err := e.admin.GetGroupDescription(ctx, name)
+	if madmin.ToErrorResponse(err).Code == codeNoSuchGroup {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, errGetGroup)
+	}
+	// Only empty groups can be removed.
+	if len(desc.Members) > 0 {
+		if !ptr.Deref(cr.Spec.ForProvider.ForceDestroy, false) {
+			return errors.New(errGroupHasUsers)
+		}
+		if err := e.admin.UpdateGroupMembers(ctx, madmin.GroupAddRemove{Group: name, Members: desc.Members, IsRemove: true}); err != nil {
+			return errors.Wrap(err, errDeleteGroup)
+		}
+	}
+	return errors.Wrap(e.admin.UpdateGroupMembers(ctx, madmin.GroupAddRemove{Group: name, Members: []string{}, IsRemove: true}), errDeleteGroup)
+}
@@ -0,0 +1,77 @@
+package group
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/upjet/pkg/resource"
+	"github.com/minio/madmin-go/v3"
+	"k8s.io/utils/ptr"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1"
+	"github.com/markopolo123/provider-upjet-minio/internal/controller/native/nativetest"
+)
+
+// TestNativeEquivalence checks that groups are observed with the same status
+// by the Terraform provider and with the MinIO Go SDKs.
+func TestNativeEquivalence(t *testing.T) {
+	c := nativetest.Clients(t)
+	nativetest.CompareWithTerraform(t, "minio_iam_group", &nativeExternal{admin: c.Admin}, func(name string) resource.Terraformed {
+		cr := &v1alpha1.Group{}
+		meta.SetExternalName(cr, name)
+		return cr
+	})
+}
+
+// TestNativeLifecycle checks that a group is created, updated and deleted
+// with the MinIO Go SDKs.
+func TestNativeLifecycle(t *testing.T) {
+	c := nativetest.Clients(t)
+	ctx := context.Background()
+	e := &nativeExternal{admin: c.Admin}
+	name := nativetest.Name("native-group")
+	cr := &v1alpha1.Group{}
+	meta.SetExternalName(cr, name)
+
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceExists {
+		t.Fatalf("want no group before Create, got %+v (%v)", obs, err)
+	}
+	if _, err := e.Create(ctx, cr); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Fatalf("want an existing, up to date group, got %+v (%v)", obs, err)
+	}
+
+	cr.Spec.ForProvider.DisableGroup = ptr.To(true)
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Fatalf("want a disabled group to be out of date, got %+v (%v)", obs, err)
+	}
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceUpToDate {
+		t.Fatalf("want an updated group to be up to date, got %+v (%v)", obs, err)
+	}
+
+	user := nativetest.Name("native-member")
+	if err := c.Admin.AddUser(ctx, user, "password1234"); err != nil {
+		t.Fatalf("AddUser: %v", err)
+	}
+	t.Cleanup(func() { _ = c.Admin.RemoveUser(ctx, user) })
+	if err := c.Admin.UpdateGroupMembers(ctx, madmin.GroupAddRemove{Group: name, Members: []string{user}}); err != nil {
+		t.Fatalf("UpdateGroupMembers: %v", err)
+	}
+	if err := e.Delete(ctx, cr); err == nil || !strings.Contains(err.Error(), errGroupHasUsers) {
+		t.Fatalf("want a group with members to be kept, got %v", err)
+	}
+	cr.Spec.ForProvider.ForceDestroy = ptr.To(true)
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceExists {
+		t.Fatalf("want a deleted group, got %+v (%v)", obs, err)
+	}
+}
@@ -0,0 +1,174 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/upjet/pkg/config"
+	tjcontroller "github.com/crossplane/upjet/pkg/controller"
+	"github.com/crossplane/upjet/pkg/terraform"
+	"github.com/minio/madmin-go/v3"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1"
+	"github.com/markopolo123/provider-upjet-minio/internal/controller/native"
+)
+
+const (
+	errNotPolicy    = "managed resource is not a Policy"
+	errNoPolicy     = "policy is required"
+	errGetPolicy    = "cannot get policy"
+	errAddPolicy    = "cannot add policy"
+	errRemovePolicy = "cannot remove policy"
+	errParsePolicy  = "cannot parse policy as JSON"
+
+	codeNoSuchPolicy = "XMinioAdminNoSuchPolicy"
+)
+
+// newConnector returns the connector of the Policy controller, which
+// reconciles Policies with the MinIO Go SDKs if the kind is reconciled
+// natively, and with Terraform otherwise.
+func newConnector(kube client.Client, ws *terraform.WorkspaceStore, sf terraform.SetupFn, cfg *config.Resource, opts ...tjcontroller.Option) managed.ExternalConnecter {
+	return native.NewConnector(kube, v1alpha1.Policy_GroupVersionKind, tjcontroller.NewConnector(kube, ws, sf, cfg, opts...), func(c native.Clients) managed.ExternalClient {
+		return &nativeExternal{admin: c.Admin}
+	})
+}
+
+// nativeExternal manages the canned policy named by the external name.
+type nativeExternal struct {
+	admin *madmin.AdminClient
+}
+
+func (e *nativeExternal) Observe(ctx context.Context, mg xpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPolicy)
+	}
+	name := meta.GetExternalName(cr)
+	info, err := e.admin.InfoCannedPolicyV2(ctx, name)
+	if madmin.ToErrorResponse(err).Code == codeNoSuchPolicy {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPolicy)
+	}
+
+	cr.Status.AtProvider = v1alpha1.PolicyObservation{
+		ID:     ptr.To(name),
+		Policy: ptr.To(string(info.Policy)),
+	}
+	cr.SetConditions(xpv1.Available())
+
+	upToDate, err := equivalent(ptr.Deref(cr.Spec.ForProvider.Policy, ""), string(info.Policy))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *nativeExternal) Create(ctx context.Context, mg xpresource.Managed) (managed.ExternalCreation, error) {
+	_, err := e.Update(ctx, mg)
+	return managed.ExternalCreation{}, err
+}
+
+func (e *nativeExternal) Update(ctx context.Context, mg xpresource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPolicy)
+	}
+	if cr.Spec.ForProvider.Policy == nil {
+		return managed.ExternalUpdate{}, errors.New(errNoPolicy)
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(e.admin.AddCannedPolicy(ctx, meta.GetExternalName(cr), []byte(*cr.Spec.ForProvider.Policy)), errAddPolicy)
+}
+
+func (e *nativeExternal) Delete(ctx context.Context, mg xpresource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return errors.New(errNotPolicy)
+	}
+	err := e.admin.RemoveCannedPolicy(ctx, meta.GetExternalName(cr))
+	if madmin.ToErrorResponse(err).Code == codeNoSuchPolicy {
+		return nil
+	}
+	return errors.Wrap(err, errRemovePolicy)
+}
+
+// equivalent returns whether two policy documents grant the same access.
+// MinIO does not store policies as they were written: single values become
+// lists, and lists may be reordered.
+func equivalent(a, b string) (bool, error) {
+	var da, db any
+	if err := json.Unmarshal([]byte(a), &da); err != nil {
+		return false, errors.Wrap(err, errParsePolicy)
+	}
+	if err := json.Unmarshal([]byte(b), &db); err != nil {
+		return false, errors.Wrap(err, errParsePolicy)
+	}
+	return reflect.DeepEqual(normalize("", da), normalize("", db)), nil
+}
+
+// normalize turns every value of the supplied policy document that may be a
+// string or a list of strings into a sorted list, and drops empty values.
+func normalize(key string, v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(t))
+		for k, e := range t {
+			if n := normalize(k, e); n != nil {
+				m[k] = n
+			}
+		}
+		if len(m) == 0 {
+			return nil
+		}
+		return m
+	case []any:
+		l := make([]any, 0, len(t))
+		for _, e := range t {
+			// The strings of a list are already in a list.
+			if s, ok := e.(string); ok {
+				if s != "" {
+					l = append(l, s)
+				}
+				continue
+			}
+			if n := normalize(key, e); n != nil {
+				l = append(l, n)
+			}
+		}
+		if len(l) == 0 {
+			return nil
+		}
+		if key == "Statement" && len(l) == 1 {
+			return l[0]
+		}
+		sort.Slice(l, func(i, j int) bool {
+			bi, _ := json.Marshal(l[i])
+			bj, _ := json.Marshal(l[j])
+			return string(bi) < string(bj)
+		})
+		return l
+	case string:
+		if t == "" {
+			return nil
+		}
+		if key == "Version" || key == "Effect" || key == "Sid" || key == "Id" {
+			return t
+		}
+		return []any{t}
+	default:
+		return t
+	}
+}
@@ -0,0 +1,140 @@
+package policy
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/upjet/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1"
+	"github.com/markopolo123/provider-upjet-minio/internal/controller/native/nativetest"
+)
+
+const readOnly = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::data/*"}]}`
+
+func TestEquivalent(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   string
+		want   bool
+		errMsg string
+	}{
+		{
+			name: "same",
+			a:    readOnly,
+			b:    readOnly,
+			want: true,
+		},
+		{
+			name: "single values as lists",
+			a:    readOnly,
+			b:    `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": ["s3:GetObject"], "Resource": ["arn:aws:s3:::data/*"]}}`,
+			want: true,
+		},
+		{
+			name: "reordered actions",
+			a:    `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": ["s3:GetObject", "s3:PutObject"], "Resource": ["*"]}]}`,
+			b:    `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": ["s3:PutObject", "s3:GetObject"], "Resource": ["*"], "Condition": {}}]}`,
+			want: true,
+		},
+		{
+			name: "different actions",
+			a:    readOnly,
+			b:    `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:PutObject", "Resource": "arn:aws:s3:::data/*"}]}`,
+		},
+		{
+			name: "different effect",
+			a:    readOnly,
+			b:    strings.Replace(readOnly, "Allow", "Deny", 1),
+		},
+		{
+			name:   "invalid",
+			a:      readOnly,
+			b:      "{",
+			errMsg: errParsePolicy,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := equivalent(tc.a, tc.b)
+			if tc.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.errMsg) {
+					t.Fatalf("expected error containing %q, got %v", tc.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+// TestNativeEquivalence checks that policies are observed with the same status
+// by the Terraform provider and with the MinIO Go SDKs. Policy documents are
+// compared semantically, since MinIO does not return them as they were set.
+func TestNativeEquivalence(t *testing.T) {
+	c := nativetest.Clients(t)
+	document := cmp.FilterPath(func(p cmp.Path) bool {
+		for _, s := range p {
+			if mi, ok := s.(cmp.MapIndex); ok && mi.Key().String() == "policy" {
+				return true
+			}
+		}
+		return false
+	}, cmp.Comparer(func(a, b string) bool {
+		ok, err := equivalent(a, b)
+		return err == nil && ok
+	}))
+	nativetest.CompareWithTerraform(t, "minio_iam_policy", &nativeExternal{admin: c.Admin}, func(name string) resource.Terraformed {
+		cr := &v1alpha1.Policy{Spec: v1alpha1.PolicySpec{ForProvider: v1alpha1.PolicyParameters{Policy: ptr.To(readOnly)}}}
+		meta.SetExternalName(cr, name)
+		return cr
+	}, document)
+}
+
+// TestNativeLifecycle checks that a policy is created, updated and deleted
+// with the MinIO Go SDKs.
+func TestNativeLifecycle(t *testing.T) {
+	c := nativetest.Clients(t)
+	ctx := context.Background()
+	e := &nativeExternal{admin: c.Admin}
+	name := nativetest.Name("native-policy")
+	cr := &v1alpha1.Policy{Spec: v1alpha1.PolicySpec{ForProvider: v1alpha1.PolicyParameters{Policy: ptr.To(readOnly)}}}
+	meta.SetExternalName(cr, name)
+
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceExists {
+		t.Fatalf("want no policy before Create, got %+v (%v)", obs, err)
+	}
+	if _, err := e.Create(ctx, cr); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Fatalf("want an existing, up to date policy, got %+v (%v)", obs, err)
+	}
+
+	cr.Spec.ForProvider.Policy = ptr.To(strings.Replace(readOnly, "s3:GetObject", "s3:PutObject", 1))
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Fatalf("want a changed policy to be out of date, got %+v (%v)", obs, err)
+	}
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceUpToDate {
+		t.Fatalf("want an updated policy to be up to date, got %+v (%v)", obs, err)
+	}
+
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceExists {
+		t.Fatalf("want a deleted policy, got %+v (%v)", obs, err)
+	}
+}
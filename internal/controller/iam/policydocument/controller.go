@@ -0,0 +1,96 @@
+package policydocument
+
+import (
+	"context"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/pkg/controller"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1"
+	"github.com/markopolo123/provider-upjet-minio/internal/features"
+)
+
+const (
+	errNotPolicyDocument = "managed resource is not a PolicyDocument"
+)
+
+// Setup adds a controller that reconciles PolicyDocument managed resources.
+// A PolicyDocument only renders JSON into its status, so it is reconciled
+// without Terraform and without a ProviderConfig.
+func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.PolicyDocument_GroupVersionKind.String())
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithTimeout(1 * time.Minute),
+		managed.WithPollInterval(o.PollInterval),
+	}
+	if o.Features.Enabled(features.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	r := managed.NewReconciler(mgr, xpresource.ManagedKind(v1alpha1.PolicyDocument_GroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(xpresource.DesiredStateChanged()).
+		For(&v1alpha1.PolicyDocument{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// connector produces an external client that renders policy documents.
+type connector struct{}
+
+func (c *connector) Connect(_ context.Context, _ xpresource.Managed) (managed.ExternalClient, error) {
+	return &external{}, nil
+}
+
+// external renders a policy document on every observation. Nothing exists
+// outside of the cluster, so creating, updating and deleting are no-ops.
+type external struct{}
+
+func (e *external) Observe(_ context.Context, mg xpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PolicyDocument)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPolicyDocument)
+	}
+	if meta.WasDeleted(cr) {
+		// Report the document as gone so that the finalizer is removed.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	doc, err := Render(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.JSON = &doc
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(_ context.Context, _ xpresource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(_ context.Context, _ xpresource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(_ context.Context, _ xpresource.Managed) error {
+	return nil
+}
@@ -0,0 +1,188 @@
+package policydocument
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1"
+)
+
+const (
+	errParseSourceJSON   = "cannot parse sourceJson"
+	errParseOverrideJSON = "cannot parse overrideJson"
+	errRenderStatement   = "cannot render statement"
+	errRenderDocument    = "cannot render policy document"
+
+	defaultEffect = "Allow"
+)
+
+// document is the rendered form of a policy document. Statements are kept as
+// raw JSON so that statements coming from sourceJson and overrideJson are
+// passed through unchanged.
+type document struct {
+	Version   string            `json:"Version,omitempty"`
+	ID        string            `json:"Id,omitempty"`
+	Statement []json.RawMessage `json:"Statement"`
+}
+
+// statement is the rendered form of a PolicyDocumentStatement.
+type statement struct {
+	Sid       string                         `json:"Sid,omitempty"`
+	Effect    string                         `json:"Effect"`
+	Principal json.RawMessage                `json:"Principal,omitempty"`
+	Action    []string                       `json:"Action,omitempty"`
+	Resource  []string                       `json:"Resource,omitempty"`
+	Condition map[string]map[string][]string `json:"Condition,omitempty"`
+}
+
+// sidStatement is a rendered statement along with its statement ID.
+type sidStatement struct {
+	sid string
+	raw json.RawMessage
+}
+
+// statements is an ordered list of statements that can be merged by ID.
+type statements []sidStatement
+
+// merge replaces the statement with the same ID as s, or appends s if it
+// has no ID or no statement with its ID exists yet.
+func (l statements) merge(s sidStatement) statements {
+	if s.sid != "" {
+		for i := range l {
+			if l[i].sid == s.sid {
+				l[i] = s
+				return l
+			}
+		}
+	}
+	return append(l, s)
+}
+
+// parseDocument parses the version, ID and statements of a policy JSON.
+func parseDocument(in string) (*document, statements, error) {
+	d := &document{}
+	if err := json.Unmarshal([]byte(in), d); err != nil {
+		return nil, nil, err
+	}
+	l := make(statements, 0, len(d.Statement))
+	for _, raw := range d.Statement {
+		s := struct {
+			Sid string `json:"Sid"`
+		}{}
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, nil, err
+		}
+		l = append(l, sidStatement{sid: s.Sid, raw: raw})
+	}
+	return d, l, nil
+}
+
+// renderStatement renders a statement of the spec into its JSON form.
+func renderStatement(in v1alpha1.PolicyDocumentStatement) (sidStatement, error) {
+	s := statement{
+		Sid:      ptrValue(in.Sid),
+		Effect:   ptrValue(in.Effect),
+		Action:   sorted(in.Actions),
+		Resource: sorted(in.Resources),
+	}
+	if s.Effect == "" {
+		s.Effect = defaultEffect
+	}
+	if p := strings.TrimSpace(ptrValue(in.Principal)); p != "" {
+		// A principal is either a JSON object such as {"AWS": ["*"]} or a
+		// plain string such as "*".
+		if strings.HasPrefix(p, "{") {
+			if !json.Valid([]byte(p)) {
+				return sidStatement{}, errors.Errorf("principal is not valid JSON: %s", p)
+			}
+			s.Principal = json.RawMessage(p)
+		} else {
+			raw, err := json.Marshal(p)
+			if err != nil {
+				return sidStatement{}, err
+			}
+			s.Principal = raw
+		}
+	}
+	for _, c := range in.Condition {
+		if s.Condition == nil {
+			s.Condition = map[string]map[string][]string{}
+		}
+		if s.Condition[c.Test] == nil {
+			s.Condition[c.Test] = map[string][]string{}
+		}
+		s.Condition[c.Test][c.Variable] = sorted(append(s.Condition[c.Test][c.Variable], c.Values...))
+	}
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return sidStatement{}, err
+	}
+	return sidStatement{sid: s.Sid, raw: raw}, nil
+}
+
+// Render renders the policy JSON of the supplied parameters. Statements of
+// sourceJson come first, statements of the spec replace the source statements
+// with the same ID or are appended, and statements of overrideJson finally
+// replace the statements with the same ID or are appended.
+func Render(p v1alpha1.PolicyDocumentParameters) (string, error) {
+	out := document{}
+	var l statements
+	if src := ptrValue(p.SourceJSON); src != "" {
+		d, sl, err := parseDocument(src)
+		if err != nil {
+			return "", errors.Wrap(err, errParseSourceJSON)
+		}
+		out.Version, out.ID = d.Version, d.ID
+		l = sl
+	}
+	if v := ptrValue(p.Version); v != "" {
+		out.Version = v
+	}
+	if id := ptrValue(p.PolicyID); id != "" {
+		out.ID = id
+	}
+	for i, in := range p.Statement {
+		s, err := renderStatement(in)
+		if err != nil {
+			return "", errors.Wrapf(err, "%s %d", errRenderStatement, i)
+		}
+		l = l.merge(s)
+	}
+	if ovr := ptrValue(p.OverrideJSON); ovr != "" {
+		_, ol, err := parseDocument(ovr)
+		if err != nil {
+			return "", errors.Wrap(err, errParseOverrideJSON)
+		}
+		for _, s := range ol {
+			l = l.merge(s)
+		}
+	}
+	out.Statement = make([]json.RawMessage, 0, len(l))
+	for _, s := range l {
+		out.Statement = append(out.Statement, s.raw)
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return "", errors.Wrap(err, errRenderDocument)
+	}
+	return string(b), nil
+}
+
+func sorted(in []string) []string {
+	if len(in) == 0 {
+		return nil
+	}
+	out := append([]string(nil), in...)
+	sort.Strings(out)
+	return out
+}
+
+func ptrValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,100 @@
+package policydocument
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name   string
+		params v1alpha1.PolicyDocumentParameters
+		want   string
+		errMsg string
+	}{
+		{
+			name: "Statements are rendered with sorted actions and resources",
+			params: v1alpha1.PolicyDocumentParameters{
+				Version: ptr.To("2012-10-17"),
+				Statement: []v1alpha1.PolicyDocumentStatement{{
+					Sid:       ptr.To("ReadWrite"),
+					Actions:   []string{"s3:PutObject", "s3:GetObject"},
+					Resources: []string{"arn:aws:s3:::bucket/*"},
+				}},
+			},
+			want: `{"Version":"2012-10-17","Statement":[{"Sid":"ReadWrite","Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":["arn:aws:s3:::bucket/*"]}]}`,
+		},
+		{
+			name: "Principals and conditions",
+			params: v1alpha1.PolicyDocumentParameters{
+				Statement: []v1alpha1.PolicyDocumentStatement{
+					{
+						Effect:    ptr.To("Deny"),
+						Principal: ptr.To("*"),
+						Actions:   []string{"s3:GetObject"},
+						Condition: []v1alpha1.PolicyDocumentCondition{
+							{Test: "IpAddress", Variable: "aws:SourceIp", Values: []string{"10.0.0.0/8"}},
+						},
+					},
+					{
+						Principal: ptr.To(`{"AWS":["*"]}`),
+						Actions:   []string{"s3:ListBucket"},
+					},
+				},
+			},
+			want: `{"Statement":[{"Effect":"Deny","Principal":"*","Action":["s3:GetObject"],"Condition":{"IpAddress":{"aws:SourceIp":["10.0.0.0/8"]}}},{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["s3:ListBucket"]}]}`,
+		},
+		{
+			name: "Spec statements replace source statements and override statements replace spec statements",
+			params: v1alpha1.PolicyDocumentParameters{
+				SourceJSON: ptr.To(`{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:*"},{"Sid":"B","Effect":"Allow","Action":"s3:*"}]}`),
+				Statement: []v1alpha1.PolicyDocumentStatement{
+					{Sid: ptr.To("A"), Actions: []string{"s3:GetObject"}},
+					{Sid: ptr.To("C"), Actions: []string{"s3:GetObject"}},
+				},
+				OverrideJSON: ptr.To(`{"Statement":[{"Sid":"C","Effect":"Deny","Action":"s3:*"}]}`),
+			},
+			want: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":["s3:GetObject"]},{"Sid":"B","Effect":"Allow","Action":"s3:*"},{"Sid":"C","Effect":"Deny","Action":"s3:*"}]}`,
+		},
+		{
+			name: "Invalid source JSON",
+			params: v1alpha1.PolicyDocumentParameters{
+				SourceJSON: ptr.To(`{`),
+			},
+			errMsg: errParseSourceJSON,
+		},
+		{
+			name: "Invalid principal JSON",
+			params: v1alpha1.PolicyDocumentParameters{
+				Statement: []v1alpha1.PolicyDocumentStatement{{Principal: ptr.To(`{"AWS":`)}},
+			},
+			errMsg: "principal is not valid JSON",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(tt.params)
+
+			if tt.errMsg != "" {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("expected error to contain '%s' but got: %s", tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Render() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
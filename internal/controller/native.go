@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/crossplane/upjet/pkg/controller"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	iamv1alpha1 "github.com/markopolo123/provider-upjet-minio/apis/iam/v1alpha1"
+	s3v1alpha1 "github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1"
+	"github.com/markopolo123/provider-upjet-minio/internal/controller/native"
+	bucket "github.com/markopolo123/provider-upjet-minio/internal/controller/s3/bucket"
+)
+
+const errUnknownNativeKind = "kind %q cannot be reconciled natively; supported kinds are %s"
+
+// nativeKinds are the kinds that can be reconciled with the MinIO Go SDKs
+// instead of Terraform.
+var nativeKinds = map[string]schema.GroupVersionKind{
+	s3v1alpha1.Bucket_Kind:           s3v1alpha1.Bucket_GroupVersionKind,
+	s3v1alpha1.BucketVersioning_Kind: s3v1alpha1.BucketVersioning_GroupVersionKind,
+	iamv1alpha1.Group_Kind:           iamv1alpha1.Group_GroupVersionKind,
+	iamv1alpha1.Policy_Kind:          iamv1alpha1.Policy_GroupVersionKind,
+}
+
+// SetupWithNativeClients creates all controllers like Setup, except that the
+// controllers of the supplied kinds reconcile them with the MinIO Go SDKs
+// instead of Terraform. It also indexes the BucketVersionings that the drift
+// check of Buckets reads.
+func SetupWithNativeClients(mgr ctrl.Manager, o controller.Options, kinds []string) error {
+	for _, k := range kinds {
+		gvk, ok := nativeKinds[k]
+		if !ok {
+			supported := make([]string, 0, len(nativeKinds))
+			for s := range nativeKinds {
+				supported = append(supported, s)
+			}
+			sort.Strings(supported)
+			return errors.Errorf(errUnknownNativeKind, k, strings.Join(supported, ", "))
+		}
+		native.Enable(gvk)
+	}
+	if err := bucket.IndexBucketVersionings(context.Background(), mgr.GetFieldIndexer()); err != nil {
+		return err
+	}
+	return Setup(mgr, o)
+}
@@ -0,0 +1,85 @@
+// Package native reconciles managed resources with the MinIO Go SDKs instead
+// of Terraform. The resources keep the schema of their Terraform based
+// controllers, and are reconciled by the same controllers with a different
+// connector, so a kind can be switched between the two at any time.
+package native
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/markopolo123/provider-upjet-minio/internal/clients"
+)
+
+// Clients are the clients of the MinIO server of a managed resource.
+type Clients struct {
+	S3    *minio.Client
+	Admin *madmin.AdminClient
+	// Server is the host and port of the MinIO server.
+	Server string
+	// Region is the region buckets are created in.
+	Region string
+}
+
+// An ExternalFn returns the external client of a managed resource.
+type ExternalFn func(c Clients) managed.ExternalClient
+
+// kinds are the kinds whose managed resources are reconciled natively.
+var kinds = map[schema.GroupVersionKind]bool{}
+
+// Enable makes the controller of the supplied kind reconcile its managed
+// resources with the MinIO Go SDKs instead of Terraform. It must be called
+// before the controllers are set up.
+func Enable(gvk schema.GroupVersionKind) {
+	kinds[gvk] = true
+}
+
+// NewConnector returns the connector of the controller of the supplied kind,
+// which produces the external clients returned by fn if the kind is
+// reconciled natively, and is the supplied Terraform based connector
+// otherwise.
+func NewConnector(kube client.Client, gvk schema.GroupVersionKind, tf managed.ExternalConnecter, fn ExternalFn) managed.ExternalConnecter {
+	if !kinds[gvk] {
+		return tf
+	}
+	return &connector{kube: kube, newExternalFn: fn}
+}
+
+// connector produces an external client for the MinIO server of the
+// ProviderConfig referenced by a managed resource.
+type connector struct {
+	kube          client.Client
+	newExternalFn ExternalFn
+}
+
+func (c *connector) Connect(ctx context.Context, mg xpresource.Managed) (managed.ExternalClient, error) {
+	creds, err := clients.ConnectCredentials(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl, err := NewClients(creds)
+	if err != nil {
+		return nil, err
+	}
+	return c.newExternalFn(cl), nil
+}
+
+// NewClients returns the clients of the MinIO server described by the
+// supplied credentials.
+func NewClients(creds map[string]string) (Clients, error) {
+	s3, err := clients.NewMinioClient(creds)
+	if err != nil {
+		return Clients{}, err
+	}
+	admin, err := clients.NewAdminClient(creds)
+	if err != nil {
+		return Clients{}, err
+	}
+	return Clients{S3: s3, Admin: admin, Server: creds["minio_server"], Region: creds["minio_region"]}, nil
+}
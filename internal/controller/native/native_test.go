@@ -0,0 +1,32 @@
+package native
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type terraformConnector struct{}
+
+func (terraformConnector) Connect(_ context.Context, _ xpresource.Managed) (managed.ExternalClient, error) {
+	return nil, nil
+}
+
+func TestNewConnector(t *testing.T) {
+	tf := terraformConnector{}
+	fn := func(_ Clients) managed.ExternalClient { return nil }
+	enabled := schema.GroupVersionKind{Group: "test.minio.crossplane.io", Version: "v1alpha1", Kind: "Enabled"}
+	disabled := schema.GroupVersionKind{Group: "test.minio.crossplane.io", Version: "v1alpha1", Kind: "Disabled"}
+	Enable(enabled)
+	t.Cleanup(func() { delete(kinds, enabled) })
+
+	if _, ok := NewConnector(nil, enabled, tf, fn).(*connector); !ok {
+		t.Errorf("NewConnector(%s): want the native connector", enabled.Kind)
+	}
+	if c := NewConnector(nil, disabled, tf, fn); c != managed.ExternalConnecter(tf) {
+		t.Errorf("NewConnector(%s): want the Terraform connector, got %T", disabled.Kind, c)
+	}
+}
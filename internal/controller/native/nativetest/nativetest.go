@@ -0,0 +1,48 @@
+// Package nativetest connects the equivalence tests of the native controllers
+// to a MinIO server.
+package nativetest
+
+import (
+	"fmt"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/markopolo123/provider-upjet-minio/internal/controller/native"
+)
+
+// Environment variables that describe the MinIO server to test against.
+const (
+	EnvServer   = "MINIO_SERVER"
+	EnvUser     = "MINIO_USER"
+	EnvPassword = "MINIO_PASSWORD"
+)
+
+var counter uint32
+
+// Clients returns the clients of the MinIO server described by the
+// environment, and skips the test when no server is configured.
+func Clients(t *testing.T) native.Clients {
+	t.Helper()
+	server := os.Getenv(EnvServer)
+	if server == "" {
+		t.Skipf("%s is not set", EnvServer)
+	}
+	c, err := native.NewClients(map[string]string{
+		"minio_server":   server,
+		"minio_user":     os.Getenv(EnvUser),
+		"minio_password": os.Getenv(EnvPassword),
+		"minio_region":   "us-east-1",
+	})
+	if err != nil {
+		t.Fatalf("cannot create clients: %v", err)
+	}
+	return c
+}
+
+// Name returns a name with the supplied prefix that is unique to the test
+// run, so that runs against the same server do not collide.
+func Name(prefix string) string {
+	return fmt.Sprintf("%s-%d-%d", prefix, time.Now().Unix(), atomic.AddUint32(&counter, 1))
+}
@@ -0,0 +1,149 @@
+package nativetest
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/pkg/controller"
+	"github.com/crossplane/upjet/pkg/resource"
+	"github.com/crossplane/upjet/pkg/terraform"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/markopolo123/provider-upjet-minio/config"
+)
+
+// Environment variables that describe the Terraform setup to compare the
+// native controllers with. The Makefile exports them.
+const (
+	EnvTerraformVersion         = "TERRAFORM_VERSION"
+	EnvTerraformProviderSource  = "TERRAFORM_PROVIDER_SOURCE"
+	EnvTerraformProviderVersion = "TERRAFORM_PROVIDER_VERSION"
+)
+
+// Terraform returns an external client that manages mg with the Terraform
+// provider, like the generated controller of its kind does, on the MinIO
+// server described by the environment. Operations are synchronous. The test
+// is skipped when no server is configured or terraform is not on the PATH.
+func Terraform(t *testing.T, resourceType string, mg resource.Terraformed) managed.ExternalClient {
+	t.Helper()
+	server := os.Getenv(EnvServer)
+	if server == "" {
+		t.Skipf("%s is not set", EnvServer)
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skipf("terraform is not on the PATH: %v", err)
+	}
+	r, ok := config.GetProvider().Resources[resourceType]
+	if !ok {
+		t.Fatalf("unknown resource type %s", resourceType)
+	}
+	cfg := *r
+	cfg.UseAsync = false
+
+	setup := func(_ context.Context, _ client.Client, _ xpresource.Managed) (terraform.Setup, error) {
+		return terraform.Setup{
+			Version: getenv(EnvTerraformVersion, "1.5.7"),
+			Requirement: terraform.ProviderRequirement{
+				Source:  getenv(EnvTerraformProviderSource, "aminueza/minio"),
+				Version: getenv(EnvTerraformProviderVersion, "3.6.3"),
+			},
+			Configuration: map[string]any{
+				"minio_server":   server,
+				"minio_user":     os.Getenv(EnvUser),
+				"minio_password": os.Getenv(EnvPassword),
+				"minio_region":   "us-east-1",
+			},
+			Scheduler: terraform.NewNoOpProviderScheduler(),
+		}, nil
+	}
+	store := terraform.NewWorkspaceStore(logging.NewNopLogger())
+	mg.SetUID(uuid.NewUUID())
+	mg.SetManagementPolicies(xpv1.ManagementPolicies{xpv1.ManagementActionAll})
+	e, err := tjcontroller.NewConnector(fake.NewClientBuilder().Build(), store, setup, &cfg).Connect(context.Background(), mg)
+	if err != nil {
+		t.Fatalf("cannot connect to Terraform: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := store.Remove(mg); err != nil {
+			t.Errorf("cannot remove the Terraform workspace: %v", err)
+		}
+	})
+	return e
+}
+
+// CompareWithTerraform checks that the native external client of a kind and
+// the Terraform provider observe the same external resources with the same
+// status. An external resource is created with each of them and observed with
+// both, and the observations are compared. newMg returns a managed resource
+// for the external resource with the supplied name, and is called once for
+// each client that observes it. The external resources are deleted with the
+// native client.
+func CompareWithTerraform(t *testing.T, resourceType string, native managed.ExternalClient, newMg func(name string) resource.Terraformed, opts ...cmp.Option) {
+	t.Helper()
+	ctx := context.Background()
+	type side struct {
+		name string
+		ext  func(t *testing.T, mg resource.Terraformed) managed.ExternalClient
+	}
+	tf := side{name: "Terraform", ext: func(t *testing.T, mg resource.Terraformed) managed.ExternalClient {
+		t.Helper()
+		return Terraform(t, resourceType, mg)
+	}}
+	nc := side{name: "native", ext: func(*testing.T, resource.Terraformed) managed.ExternalClient { return native }}
+
+	for _, s := range [][2]side{{tf, nc}, {nc, tf}} {
+		creator, observer := s[0], s[1]
+		t.Run("CreatedBy"+creator.name, func(t *testing.T) {
+			name := Name(strings.ReplaceAll(resourceType, "_", "-"))
+			created := newMg(name)
+			ext := creator.ext(t, created)
+			if _, err := ext.Create(ctx, created); err != nil {
+				t.Fatalf("%s Create: %v", creator.name, err)
+			}
+			t.Cleanup(func() {
+				if err := native.Delete(ctx, created); err != nil {
+					t.Errorf("Delete: %v", err)
+				}
+			})
+			if obs, err := ext.Observe(ctx, created); err != nil || !obs.ResourceExists || !obs.ResourceUpToDate {
+				t.Fatalf("%s Observe: want an existing, up to date resource, got %+v (%v)", creator.name, obs, err)
+			}
+
+			observed := newMg(name)
+			meta.SetExternalName(observed, meta.GetExternalName(created))
+			if obs, err := observer.ext(t, observed).Observe(ctx, observed); err != nil || !obs.ResourceExists || !obs.ResourceUpToDate {
+				t.Fatalf("%s Observe: want an existing, up to date resource, got %+v (%v)", observer.name, obs, err)
+			}
+
+			want, err := created.GetObservation()
+			if err != nil {
+				t.Fatalf("cannot get the observation of the %s client: %v", creator.name, err)
+			}
+			got, err := observed.GetObservation()
+			if err != nil {
+				t.Fatalf("cannot get the observation of the %s client: %v", observer.name, err)
+			}
+			if diff := cmp.Diff(want, got, opts...); diff != "" {
+				t.Errorf("status.atProvider: -%s, +%s:\n%s", creator.name, observer.name, diff)
+			}
+		})
+	}
+}
+
+func getenv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -2,13 +2,15 @@ package providerconfig
 
 import (
 	"context"
-	"crypto/tls"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -16,19 +18,103 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/upjet/pkg/controller"
+	"github.com/minio/minio-go/v7/pkg/signer"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/markopolo123/provider-upjet-minio/apis/v1beta1"
+	"github.com/markopolo123/provider-upjet-minio/internal/clients"
 )
 
 const (
-	errGetProviderConfig  = "cannot get ProviderConfig"
-	errExtractCredentials = "cannot extract credentials"
+	errGetProviderConfig   = "cannot get ProviderConfig"
+	errExtractCredentials  = "cannot extract credentials"
 	errValidateCredentials = "cannot validate credentials"
+
+	// emptySHA256 is the SHA256 hash of an empty request body.
+	emptySHA256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	// maxResponseSize is the largest response body that is read.
+	maxResponseSize = 1 << 20
+
+	// defaultHealthCheckInterval is how often a ProviderConfig is
+	// re-validated unless its spec says otherwise.
+	defaultHealthCheckInterval = 5 * time.Minute
+
+	// minHealthCheckBackoff is the first delay before re-validating a
+	// ProviderConfig that failed validation.
+	minHealthCheckBackoff = 10 * time.Second
 )
 
+// ReasonInvalidCredentials is the reason of the Ready condition of a
+// ProviderConfig whose credentials were rejected by MinIO, as opposed to a
+// MinIO server that cannot be reached.
+const ReasonInvalidCredentials xpv1.ConditionReason = "InvalidCredentials"
+
+// ReasonInsufficientPermissions is the reason of the Ready condition of a
+// ProviderConfig whose credentials MinIO accepts, but whose policies do not
+// allow them to list buckets.
+const ReasonInsufficientPermissions xpv1.ConditionReason = "InsufficientPermissions"
+
+// invalidCredentialCodes are the S3 and MinIO admin error codes returned for
+// credentials that MinIO does not accept. AccessDenied is returned for valid
+// credentials without the required permissions, and is not one of them.
+var invalidCredentialCodes = map[string]bool{
+	"InvalidAccessKeyId":          true,
+	"SignatureDoesNotMatch":       true,
+	"XMinioAdminInvalidAccessKey": true,
+	"XMinioAdminInvalidSecretKey": true,
+	"ExpiredToken":                true,
+	"InvalidToken":                true,
+	"InvalidTokenId":              true,
+}
+
+// s3Error is the body of an S3 error response.
+type s3Error struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+// invalidCredentialsError is returned when MinIO rejects the credentials.
+type invalidCredentialsError struct {
+	s3Error
+}
+
+func (e *invalidCredentialsError) Error() string {
+	return fmt.Sprintf("MinIO rejected the credentials: %s: %s", e.Code, e.Message)
+}
+
+// accessDeniedError is returned when MinIO accepts the credentials but denies
+// the request.
+type accessDeniedError struct {
+	s3Error
+}
+
+func (e *accessDeniedError) Error() string {
+	return fmt.Sprintf("MinIO denied access to the credentials: %s: %s", e.Code, e.Message)
+}
+
+// signRequest signs req with the credentials, using the signature version
+// selected by minio_api_version.
+func signRequest(req *http.Request, creds map[string]string) *http.Request {
+	accessKey, secretKey := creds["minio_user"], creds["minio_password"]
+	if creds["minio_api_version"] == "v2" {
+		return signer.SignV2(*req, accessKey, secretKey, false)
+	}
+	region := creds["minio_region"]
+	if region == "" {
+		region = "us-east-1"
+	}
+	req.Header.Set("X-Amz-Content-Sha256", emptySHA256)
+	return signer.SignV4(*req, accessKey, secretKey, creds["minio_session_token"], region)
+}
+
 // buildMinioURL constructs the proper MinIO URL based on server and SSL settings
 func buildMinioURL(server string, useSSL bool) (string, error) {
 	// If server already has a protocol, return an error as per MinIO provider expectations
@@ -45,8 +131,15 @@ func buildMinioURL(server string, useSSL bool) (string, error) {
 	return fmt.Sprintf("%s://%s", protocol, server), nil
 }
 
-// validateMinioCredentials validates Minio credentials by making a test API call
-func validateMinioCredentials(ctx context.Context, creds map[string]string) error {
+// minioEndpoint is a MinIO server that signed requests are sent to.
+type minioEndpoint struct {
+	url    string
+	client *http.Client
+	creds  map[string]string
+}
+
+// newMinioEndpoint returns the MinIO server described by the credentials.
+func newMinioEndpoint(creds map[string]string) (*minioEndpoint, error) {
 	server := creds["minio_server"]
 	
 	// Parse SSL setting (default to false if not provided)
@@ -55,7 +148,7 @@ func validateMinioCredentials(ctx context.Context, creds map[string]string) erro
 		var err error
 		useSSL, err = strconv.ParseBool(sslStr)
 		if err != nil {
-			return fmt.Errorf("invalid minio_ssl value '%s': %w", sslStr, err)
+			return nil, fmt.Errorf("invalid minio_ssl value '%s': %w", sslStr, err)
 		}
 	}
 
@@ -65,42 +158,136 @@ func validateMinioCredentials(ctx context.Context, creds map[string]string) erro
 		var err error
 		insecure, err = strconv.ParseBool(insecureStr)
 		if err != nil {
-			return fmt.Errorf("invalid minio_insecure value '%s': %w", insecureStr, err)
+			return nil, fmt.Errorf("invalid minio_insecure value '%s': %w", insecureStr, err)
 		}
 	}
 
 	// Build the proper URL
 	url, err := buildMinioURL(server, useSSL)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Create HTTP client with SSL configuration
 	transport := &http.Transport{}
-	if useSSL && insecure {
-		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	if useSSL {
+		tlsConfig, err := clients.TLSConfig(creds, insecure)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsConfig
 	}
 
-	client := &http.Client{
-		Timeout:   10 * time.Second,
-		Transport: transport,
-	}
+	return &minioEndpoint{
+		url: url,
+		client: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: transport,
+		},
+		creds: creds,
+	}, nil
+}
 
-	// Make a test request to the Minio API
-	req, err := http.NewRequestWithContext(ctx, "GET", url+"/", nil)
+// get sends a signed GET request for path and returns the response body. An
+// error is returned for responses other than 200 OK.
+func (e *minioEndpoint) get(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", e.url+path, nil)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	resp, err := client.Do(req)
+	resp, err := e.client.Do(signRequest(req, e.creds))
 	if err != nil {
-		return fmt.Errorf("failed to connect to Minio server at %s: %w", url, err)
+		return nil, fmt.Errorf("failed to connect to Minio server at %s: %w", e.url, err)
 	}
 	defer resp.Body.Close()
 
-	// For Minio, we just need to verify the server is reachable
-	// The actual authentication will be handled by the Terraform provider
-	return nil
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if resp.StatusCode == http.StatusOK {
+		return body, err
+	}
+	s3e := s3Error{}
+	_ = xml.Unmarshal(body, &s3e)
+	if invalidCredentialCodes[s3e.Code] {
+		return nil, &invalidCredentialsError{s3Error: s3e}
+	}
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, &accessDeniedError{s3Error: s3e}
+	}
+	return nil, fmt.Errorf("unexpected response from Minio server at %s: %s %s", e.url, resp.Status, s3e.Code)
+}
+
+// validate makes a signed ListBuckets request. It fails for credentials that
+// MinIO rejects, and for valid credentials whose policies do not allow
+// s3:ListAllMyBuckets.
+func (e *minioEndpoint) validate(ctx context.Context) error {
+	_, err := e.get(ctx, "/")
+	return err
+}
+
+// serverInfo is the subset of the MinIO admin server info that is recorded in
+// the status of a ProviderConfig.
+type serverInfo struct {
+	Region       string `json:"region"`
+	DeploymentID string `json:"deploymentID"`
+	Backend      struct {
+		OnlineDisks int64 `json:"onlineDisks"`
+	} `json:"backend"`
+	Servers []struct {
+		Version string `json:"version"`
+		Drives  []struct {
+			State string `json:"state"`
+		} `json:"drives"`
+	} `json:"servers"`
+}
+
+// version returns the version of the first server that reports one.
+func (i *serverInfo) version() string {
+	for _, s := range i.Servers {
+		if s.Version != "" {
+			return s.Version
+		}
+	}
+	return ""
+}
+
+// onlineDrives returns the number of online drives of the deployment.
+func (i *serverInfo) onlineDrives() int64 {
+	if i.Backend.OnlineDisks > 0 {
+		return i.Backend.OnlineDisks
+	}
+	var n int64
+	for _, s := range i.Servers {
+		for _, d := range s.Drives {
+			if d.State == "ok" {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// serverInfo returns the admin server info of the deployment. It requires
+// the admin:ServerInfo permission.
+func (e *minioEndpoint) serverInfo(ctx context.Context) (*serverInfo, error) {
+	body, err := e.get(ctx, "/minio/admin/v3/info")
+	if err != nil {
+		return nil, err
+	}
+	info := &serverInfo{}
+	if err := json.Unmarshal(body, info); err != nil {
+		return nil, fmt.Errorf("cannot parse server info: %w", err)
+	}
+	return info, nil
+}
+
+// validateMinioCredentials validates Minio credentials by making a test API call
+func validateMinioCredentials(ctx context.Context, creds map[string]string) error {
+	e, err := newMinioEndpoint(creds)
+	if err != nil {
+		return err
+	}
+	return e.validate(ctx)
 }
 
 // A Reconciler reconciles ProviderConfigs by validating their credentials
@@ -109,6 +296,11 @@ type Reconciler struct {
 	usage  resource.Tracker
 	logger logging.Logger
 	record event.Recorder
+
+	// failures counts the consecutive failed validations of each
+	// ProviderConfig, and is used to back off re-validation.
+	mu       sync.Mutex
+	failures map[string]int
 }
 
 // Reconcile a ProviderConfig
@@ -119,51 +311,128 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	pc := &v1beta1.ProviderConfig{}
 	if err := r.client.Get(ctx, req.NamespacedName, pc); err != nil {
 		log.Debug(errGetProviderConfig, "error", err)
+		if resource.IgnoreNotFound(err) == nil {
+			// Forget the failures of a deleted ProviderConfig.
+			r.backoff(req.Name, true)
+		}
 		return ctrl.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetProviderConfig)
 	}
 
-	// Extract and validate credentials
-	data, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, r.client, pc.Spec.Credentials.CommonCredentialSelectors)
-	if err != nil {
-		log.Debug(errExtractCredentials, "error", err)
-		pc.Status.SetConditions(xpv1.Unavailable().WithMessage(err.Error()))
-		return ctrl.Result{}, errors.Wrap(r.client.Status().Update(ctx, pc), "cannot update status")
+	prev := pc.Status.GetCondition(xpv1.TypeReady)
+	c := r.validate(ctx, log, pc)
+	pc.Status.SetConditions(c)
+	if err := r.client.Status().Update(ctx, pc); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "cannot update status")
 	}
 
-	creds := map[string]string{}
-	if err := json.Unmarshal(data, &creds); err != nil {
-		log.Debug("Cannot unmarshal credentials", "error", err)
-		pc.Status.SetConditions(xpv1.Unavailable().WithMessage(err.Error()))
-		return ctrl.Result{}, errors.Wrap(r.client.Status().Update(ctx, pc), "cannot update status")
+	// Emit an event whenever the ProviderConfig becomes available or
+	// unavailable.
+	if c.Status != prev.Status {
+		if c.Status == corev1.ConditionTrue {
+			r.record.Event(pc, event.Normal(event.Reason(c.Reason), "Connected to MinIO"))
+		} else {
+			r.record.Event(pc, event.Warning(event.Reason(c.Reason), errors.New(c.Message)))
+		}
 	}
 
-	server := creds["minio_server"]
-	user := creds["minio_user"]
-	password := creds["minio_password"]
+	interval := defaultHealthCheckInterval
+	if pc.Spec.HealthCheckInterval != nil {
+		interval = pc.Spec.HealthCheckInterval.Duration
+	}
+	if interval <= 0 {
+		return ctrl.Result{}, nil
+	}
+	if d := r.backoff(pc.Name, c.Status == corev1.ConditionTrue); d > 0 && d < interval {
+		interval = d
+	}
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
 
-	if server == "" || user == "" || password == "" {
-		msg := "missing required credentials: minio_server, minio_user, and minio_password"
+// backoff records the outcome of a validation and returns how long to wait
+// before re-validating a ProviderConfig that failed, doubling with every
+// consecutive failure. It returns 0 after a successful validation.
+func (r *Reconciler) backoff(name string, ok bool) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if ok {
+		delete(r.failures, name)
+		return 0
+	}
+	if r.failures == nil {
+		r.failures = map[string]int{}
+	}
+	n := r.failures[name]
+	r.failures[name] = n + 1
+	if n > 16 {
+		n = 16
+	}
+	return minHealthCheckBackoff << n
+}
+
+// validate validates the credentials of the ProviderConfig, records what it
+// points at in its status and returns its Ready condition.
+func (r *Reconciler) validate(ctx context.Context, log logging.Logger, pc *v1beta1.ProviderConfig) xpv1.Condition {
+	// Extract and validate credentials, merged with the settings in the spec
+	creds, err := clients.ProviderConfigCredentials(ctx, r.client, pc)
+	if err != nil {
+		log.Debug(errExtractCredentials, "error", err)
+		return xpv1.Unavailable().WithMessage(err.Error())
+	}
+
+	// The keys required by the credentials mode have been checked while
+	// extracting them, but the server may be set in either place.
+	if creds["minio_server"] == "" {
+		msg := "missing required credentials: minio_server (or spec.server)"
 		log.Debug(msg)
-		pc.Status.SetConditions(xpv1.Unavailable().WithMessage(msg))
-		return ctrl.Result{}, errors.Wrap(r.client.Status().Update(ctx, pc), "cannot update status")
+		return xpv1.Unavailable().WithMessage(msg)
 	}
 
 	// Always set Ready condition - skip validation in test environment
 	if os.Getenv("UPTEST_CLOUD_CREDENTIALS") != "" {
 		log.Debug("Skipping credential validation in test environment")
-		pc.Status.SetConditions(xpv1.Available())
-		return ctrl.Result{}, errors.Wrap(r.client.Status().Update(ctx, pc), "cannot update status")
+		return xpv1.Available()
 	}
 
-	if err := validateMinioCredentials(ctx, creds); err != nil {
+	e, err := newMinioEndpoint(creds)
+	if err == nil {
+		err = e.validate(ctx)
+	}
+	if err != nil {
 		log.Debug(errValidateCredentials, "error", err)
-		pc.Status.SetConditions(xpv1.Unavailable().WithMessage(err.Error()))
-		return ctrl.Result{}, errors.Wrap(r.client.Status().Update(ctx, pc), "cannot update status")
+		c := xpv1.Unavailable().WithMessage(err.Error())
+		var ice *invalidCredentialsError
+		var ade *accessDeniedError
+		switch {
+		case errors.As(err, &ice):
+			c.Reason = ReasonInvalidCredentials
+		case errors.As(err, &ade):
+			c.Reason = ReasonInsufficientPermissions
+		}
+		return c
 	}
 
-	// Set Ready condition
-	pc.Status.SetConditions(xpv1.Available())
-	return ctrl.Result{}, errors.Wrap(r.client.Status().Update(ctx, pc), "cannot update status")
+	// Record what the ProviderConfig points at. Server info requires admin
+	// permissions, so credentials without them are still available.
+	if info, err := e.serverInfo(ctx); err != nil {
+		log.Debug("Cannot get server info", "error", err)
+	} else {
+		pc.Status.ServerVersion = optional(info.version())
+		pc.Status.Region = optional(info.Region)
+		pc.Status.DeploymentID = optional(info.DeploymentID)
+		pc.Status.OnlineDrives = ptr.To(info.onlineDrives())
+	}
+	now := metav1.Now()
+	pc.Status.LastValidatedTime = &now
+
+	return xpv1.Available()
+}
+
+// optional returns a pointer to s, or nil if s is empty.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
 }
 
 // Setup adds a controller that reconciles ProviderConfigs by accounting for
@@ -181,7 +450,9 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		For(&v1beta1.ProviderConfig{}).
+		// Status updates, such as the last validated time, must not trigger
+		// another validation.
+		For(&v1beta1.ProviderConfig{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Watches(&v1beta1.ProviderConfigUsage{}, &resource.EnqueueRequestForProviderConfig{}).
 		Complete(r)
 }
\ No newline at end of file
@@ -2,11 +2,124 @@ package providerconfig
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sort"
+	"strings"
 	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/v1beta1"
 )
 
+const (
+	testAccessKey = "testuser"
+	testSecretKey = "testpass"
+
+	// deniedAccessKey is a valid user that is not allowed to list buckets.
+	deniedAccessKey = "denieduser"
+)
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// verifySignatureV4 checks the AWS Signature Version 4 of a request without a
+// query string against the supplied credentials, and returns the S3 error code
+// to respond with if the request is not correctly signed.
+func verifySignatureV4(r *http.Request, accessKey, secretKey string) string {
+	auth := strings.TrimPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ")
+	fields := map[string]string{}
+	for _, f := range strings.Split(auth, ",") {
+		k, v, _ := strings.Cut(strings.TrimSpace(f), "=")
+		fields[k] = v
+	}
+	// Credential is <access key>/<date>/<region>/s3/aws4_request
+	scope := strings.Split(fields["Credential"], "/")
+	if len(scope) != 5 {
+		return "AuthorizationHeaderMalformed"
+	}
+	if scope[0] != accessKey {
+		return "InvalidAccessKeyId"
+	}
+
+	signed := strings.Split(fields["SignedHeaders"], ";")
+	sort.Strings(signed)
+	var headers strings.Builder
+	for _, h := range signed {
+		v := r.Header.Get(h)
+		if h == "host" {
+			v = r.Host
+		}
+		fmt.Fprintf(&headers, "%s:%s\n", h, strings.TrimSpace(v))
+	}
+	canonical := strings.Join([]string{
+		r.Method,
+		r.URL.EscapedPath(),
+		r.URL.RawQuery,
+		headers.String(),
+		strings.Join(signed, ";"),
+		r.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+	hash := sha256.Sum256([]byte(canonical))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		r.Header.Get("X-Amz-Date"),
+		strings.Join(scope[1:], "/"),
+		hex.EncodeToString(hash[:]),
+	}, "\n")
+
+	key := []byte("AWS4" + secretKey)
+	for _, s := range scope[1:] {
+		key = hmacSHA256(key, s)
+	}
+	if hex.EncodeToString(hmacSHA256(key, stringToSign)) != fields["Signature"] {
+		return "SignatureDoesNotMatch"
+	}
+	return ""
+}
+
+// s3Handler answers ListBuckets requests signed with the test credentials,
+// and rejects others like MinIO does.
+func s3Handler(w http.ResponseWriter, r *http.Request) {
+	code := verifySignatureV4(r, testAccessKey, testSecretKey)
+	if verifySignatureV4(r, deniedAccessKey, testSecretKey) == "" {
+		code = "AccessDenied"
+	}
+	if code != "" {
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>%s</Code><Message>rejected</Message></Error>`, code)
+		return
+	}
+	if r.URL.Path == "/minio/admin/v3/info" {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"mode":"online","region":"eu-west-1","deploymentID":"4f1e2a","backend":{"backendType":"Erasure","onlineDisks":4},"servers":[{"version":"2024-05-10T01:41:38Z","drives":[{"state":"ok"}]}]}`))
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><ListAllMyBucketsResult><Buckets></Buckets></ListAllMyBucketsResult>`))
+}
+
 func TestBuildMinioURL(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -78,28 +191,31 @@ func TestBuildMinioURL(t *testing.T) {
 
 func TestValidateMinioCredentials(t *testing.T) {
 	// Create test HTTP server
-	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("MinIO"))
-	}))
+	httpServer := httptest.NewServer(http.HandlerFunc(s3Handler))
 	defer httpServer.Close()
 
 	// Create test HTTPS server
-	httpsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("MinIO"))
-	}))
+	httpsServer := httptest.NewTLSServer(http.HandlerFunc(s3Handler))
 	defer httpsServer.Close()
 
+	// Create test server that fails every request
+	brokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer brokenServer.Close()
+
 	// Extract host:port from test servers
 	httpServerAddr := httpServer.URL[7:] // Remove "http://" prefix
 	httpsServerAddr := httpsServer.URL[8:] // Remove "https://" prefix
+	brokenServerAddr := brokenServer.URL[7:]
 
 	tests := []struct {
-		name      string
-		creds     map[string]string
-		expectErr bool
-		errMsg    string
+		name               string
+		creds              map[string]string
+		expectErr          bool
+		errMsg             string
+		invalidCredentials bool
+		accessDenied       bool
 	}{
 		{
 			name: "Valid HTTP credentials",
@@ -122,6 +238,49 @@ func TestValidateMinioCredentials(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "Wrong password",
+			creds: map[string]string{
+				"minio_server":   httpServerAddr,
+				"minio_user":     "testuser",
+				"minio_password": "wrongpass",
+			},
+			expectErr:          true,
+			errMsg:             "SignatureDoesNotMatch",
+			invalidCredentials: true,
+		},
+		{
+			name: "Unknown access key",
+			creds: map[string]string{
+				"minio_server":   httpServerAddr,
+				"minio_user":     "someoneelse",
+				"minio_password": "testpass",
+			},
+			expectErr:          true,
+			errMsg:             "InvalidAccessKeyId",
+			invalidCredentials: true,
+		},
+		{
+			name: "Valid credentials without permissions",
+			creds: map[string]string{
+				"minio_server":   httpServerAddr,
+				"minio_user":     deniedAccessKey,
+				"minio_password": "testpass",
+			},
+			expectErr:    true,
+			errMsg:       "AccessDenied",
+			accessDenied: true,
+		},
+		{
+			name: "Server error",
+			creds: map[string]string{
+				"minio_server":   brokenServerAddr,
+				"minio_user":     "testuser",
+				"minio_password": "testpass",
+			},
+			expectErr: true,
+			errMsg:    "500 Internal Server Error",
+		},
 		{
 			name: "Invalid SSL value",
 			creds: map[string]string{
@@ -179,6 +338,14 @@ func TestValidateMinioCredentials(t *testing.T) {
 				} else if tt.errMsg != "" && !containsString(err.Error(), tt.errMsg) {
 					t.Errorf("expected error to contain '%s' but got: %s", tt.errMsg, err.Error())
 				}
+				var ice *invalidCredentialsError
+				if got := errors.As(err, &ice); got != tt.invalidCredentials {
+					t.Errorf("expected invalid credentials error to be %t but got %t", tt.invalidCredentials, got)
+				}
+				var ade *accessDeniedError
+				if got := errors.As(err, &ade); got != tt.accessDenied {
+					t.Errorf("expected access denied error to be %t but got %t", tt.accessDenied, got)
+				}
 				return
 			}
 			
@@ -191,10 +358,7 @@ func TestValidateMinioCredentials(t *testing.T) {
 
 func TestValidateMinioCredentials_DefaultValues(t *testing.T) {
 	// Create test HTTP server
-	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("MinIO"))
-	}))
+	httpServer := httptest.NewServer(http.HandlerFunc(s3Handler))
 	defer httpServer.Close()
 
 	httpServerAddr := httpServer.URL[7:] // Remove "http://" prefix
@@ -232,6 +396,128 @@ func TestValidateMinioCredentials_DefaultValues(t *testing.T) {
 	}
 }
 
+// recorder records the reasons of the events it receives.
+type recorder struct {
+	reasons []event.Reason
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) {
+	r.reasons = append(r.reasons, e.Reason)
+}
+
+func (r *recorder) WithAnnotations(...string) event.Recorder {
+	return r
+}
+
+func TestReconcile(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(s3Handler))
+	defer srv.Close()
+
+	s := runtime.NewScheme()
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatalf("cannot build scheme: %v", err)
+	}
+	if err := v1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatalf("cannot build scheme: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		user     string
+		password string
+		reason   xpv1.ConditionReason
+		requeue  []time.Duration
+		status   v1beta1.ProviderConfigStatus
+	}{
+		{
+			name:     "Valid credentials record the server info",
+			password: testSecretKey,
+			reason:   xpv1.ReasonAvailable,
+			requeue:  []time.Duration{defaultHealthCheckInterval, defaultHealthCheckInterval},
+			status: v1beta1.ProviderConfigStatus{
+				ServerVersion: ptr.To("2024-05-10T01:41:38Z"),
+				Region:        ptr.To("eu-west-1"),
+				DeploymentID:  ptr.To("4f1e2a"),
+				OnlineDrives:  ptr.To(int64(4)),
+			},
+		},
+		{
+			name:     "Rejected credentials have a distinct reason",
+			password: "wrongpass",
+			reason:   ReasonInvalidCredentials,
+			requeue:  []time.Duration{minHealthCheckBackoff, 2 * minHealthCheckBackoff, 4 * minHealthCheckBackoff},
+		},
+		{
+			name:     "Credentials without permissions are not reported as invalid",
+			user:     deniedAccessKey,
+			password: testSecretKey,
+			reason:   ReasonInsufficientPermissions,
+			requeue:  []time.Duration{minHealthCheckBackoff, 2 * minHealthCheckBackoff},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pc := &v1beta1.ProviderConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: v1beta1.ProviderConfigSpec{
+					Server: ptr.To(srv.URL[7:]),
+					Credentials: v1beta1.ProviderCredentials{
+						Source: xpv1.CredentialsSourceSecret,
+						CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{
+							SecretReference: xpv1.SecretReference{Name: "creds", Namespace: "upbound-system"},
+							Key:             "credentials",
+						}},
+					},
+				},
+			}
+			user := tt.user
+			if user == "" {
+				user = testAccessKey
+			}
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "upbound-system"},
+				Data:       map[string][]byte{"credentials": []byte(fmt.Sprintf(`{"minio_user": %q, "minio_password": %q}`, user, tt.password))},
+			}
+			kube := fake.NewClientBuilder().WithScheme(s).WithObjects(pc, secret).WithStatusSubresource(pc).Build()
+			rec := &recorder{}
+			r := &Reconciler{client: kube, logger: logging.NewNopLogger(), record: rec}
+
+			// Validation is retried with a backoff while the credentials are
+			// rejected, and only the first transition emits an event.
+			for i, want := range tt.requeue {
+				res, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "default"}})
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if res.RequeueAfter != want {
+					t.Errorf("reconcile %d: RequeueAfter = %s, want %s", i, res.RequeueAfter, want)
+				}
+			}
+			if len(rec.reasons) != 1 || rec.reasons[0] != event.Reason(tt.reason) {
+				t.Errorf("events = %v, want a single %q event", rec.reasons, tt.reason)
+			}
+
+			got := &v1beta1.ProviderConfig{}
+			if err := kube.Get(context.Background(), types.NamespacedName{Name: "default"}, got); err != nil {
+				t.Fatalf("cannot get ProviderConfig: %v", err)
+			}
+			if reason := got.Status.GetCondition(xpv1.TypeReady).Reason; reason != tt.reason {
+				t.Errorf("Ready reason = %q, want %q", reason, tt.reason)
+			}
+			if (got.Status.LastValidatedTime != nil) != (tt.reason == xpv1.ReasonAvailable) {
+				t.Errorf("lastValidatedTime = %v, want it set only for valid credentials", got.Status.LastValidatedTime)
+			}
+			if ptr.Deref(got.Status.ServerVersion, "") != ptr.Deref(tt.status.ServerVersion, "") ||
+				ptr.Deref(got.Status.Region, "") != ptr.Deref(tt.status.Region, "") ||
+				ptr.Deref(got.Status.DeploymentID, "") != ptr.Deref(tt.status.DeploymentID, "") ||
+				ptr.Deref(got.Status.OnlineDrives, 0) != ptr.Deref(tt.status.OnlineDrives, 0) {
+				t.Errorf("status = %+v, want %+v", got.Status, tt.status)
+			}
+		})
+	}
+}
+
 // Helper function to check if a string contains a substring
 func containsString(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || 
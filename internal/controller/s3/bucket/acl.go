@@ -0,0 +1,64 @@
+package bucket
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errUnknownACL    = "unknown acl %q: must be one of private, public-read, public-write, public-read-write or public"
+	errMarshalPolicy = "cannot marshal bucket policy"
+)
+
+// defaultACL is the acl of buckets that do not set one.
+const defaultACL = "private"
+
+var (
+	readBucketActions   = []string{"s3:GetBucketLocation", "s3:ListBucket"}
+	writeBucketActions  = []string{"s3:GetBucketLocation", "s3:ListBucketMultipartUploads"}
+	bothBucketActions   = []string{"s3:GetBucketLocation", "s3:ListBucket", "s3:ListBucketMultipartUploads"}
+	readObjectActions   = []string{"s3:GetObject"}
+	writeObjectActions  = []string{"s3:AbortMultipartUpload", "s3:DeleteObject", "s3:ListMultipartUploadParts", "s3:PutObject"}
+	bothObjectActions   = []string{"s3:AbortMultipartUpload", "s3:DeleteObject", "s3:GetObject", "s3:ListMultipartUploadParts", "s3:PutObject"}
+	cannedACLStatements = map[string][2][]string{
+		"public-read":       {readBucketActions, readObjectActions},
+		"public-write":      {writeBucketActions, writeObjectActions},
+		"public-read-write": {bothBucketActions, bothObjectActions},
+		"public":            {bothBucketActions, bothObjectActions},
+	}
+)
+
+type policyStatement struct {
+	Effect    string              `json:"Effect"`
+	Principal map[string][]string `json:"Principal"`
+	Action    []string            `json:"Action"`
+	Resource  []string            `json:"Resource"`
+}
+
+type policy struct {
+	Version   string            `json:"Version"`
+	Statement []policyStatement `json:"Statement"`
+}
+
+// aclPolicy returns the anonymous access policy that the Terraform provider
+// sets on a bucket for a canned acl. Private buckets have no policy.
+func aclPolicy(acl, bucket string) (string, error) {
+	if acl == defaultACL {
+		return "", nil
+	}
+	actions, ok := cannedACLStatements[acl]
+	if !ok {
+		return "", errors.Errorf(errUnknownACL, acl)
+	}
+	anyone := map[string][]string{"AWS": {"*"}}
+	p := policy{
+		Version: "2012-10-17",
+		Statement: []policyStatement{
+			{Effect: "Allow", Principal: anyone, Action: actions[0], Resource: []string{"arn:aws:s3:::" + bucket}},
+			{Effect: "Allow", Principal: anyone, Action: actions[1], Resource: []string{"arn:aws:s3:::" + bucket + "/*"}},
+		},
+	}
+	b, err := json.Marshal(p)
+	return string(b), errors.Wrap(err, errMarshalPolicy)
+}
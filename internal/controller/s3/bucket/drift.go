@@ -0,0 +1,344 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	tjresource "github.com/crossplane/upjet/pkg/resource"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1"
+	"github.com/markopolo123/provider-upjet-minio/internal/clients"
+	"github.com/markopolo123/provider-upjet-minio/internal/controller/native"
+)
+
+const (
+	errIndexBucketVersionings = "cannot index BucketVersionings by bucket"
+	errListBucketVersionings  = "cannot list BucketVersionings"
+	errGetVersioning          = "cannot get bucket versioning"
+	errDrifted                = "bucket %q has drifted from its spec: %s"
+
+	// versioningBucketField is the field BucketVersionings are indexed by,
+	// which is the name of the bucket whose versioning they manage.
+	versioningBucketField = "bucket"
+
+	// quotaTypeHard is the type of the quotas set by the Terraform provider.
+	quotaTypeHard = string(madmin.HardQuota)
+	// versioningEnabled is the versioning status object locking requires.
+	versioningEnabled = minio.Enabled
+)
+
+// bucketState is the part of the state of a bucket that is checked for
+// drift. An empty quota type means that the bucket has no quota or that it
+// was not read, and an empty versioning status that versioning was never
+// configured or not read. The usage of a bucket is only read when it has a
+// quota.
+type bucketState struct {
+	quotaSize     uint64
+	quotaType     string
+	usage         uint64
+	versioning    string
+	objectLocking bool
+}
+
+// stateReads are the parts of the state of a bucket that are read from the
+// server, which are the ones that are managed.
+type stateReads struct {
+	quota         bool
+	versioning    bool
+	objectLocking bool
+}
+
+// A bucketStateFn returns the supplied parts of the live state of the named
+// bucket. The parts that are not read are left empty.
+type bucketStateFn func(ctx context.Context, name string, r stateReads) (bucketState, error)
+
+// IndexBucketVersionings indexes BucketVersionings by the name of the bucket
+// whose versioning they manage, by which the drift check of Buckets reads
+// them.
+func IndexBucketVersionings(ctx context.Context, fi client.FieldIndexer) error {
+	return errors.Wrap(fi.IndexField(ctx, &v1alpha1.BucketVersioning{}, versioningBucketField, versioningBucket), errIndexBucketVersionings)
+}
+
+// versioningBucket returns the name of the bucket whose versioning the
+// supplied BucketVersioning manages, which is its external name.
+func versioningBucket(o client.Object) []string {
+	if name := meta.GetExternalName(o); name != "" {
+		return []string{name}
+	}
+	return nil
+}
+
+// driftConnector produces the external clients of an inner connector that
+// also check Buckets for drift.
+type driftConnector struct {
+	managed.ExternalConnecter
+	kube client.Client
+}
+
+func (c *driftConnector) Connect(ctx context.Context, mg xpresource.Managed) (managed.ExternalClient, error) {
+	ext, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	// The inner connector admitted the reconcile with the ReconcileLimiter
+	// of the ProviderConfig, so the credentials are not admitted again.
+	creds, err := clients.ExtractCredentials(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl, err := native.NewClients(creds)
+	if err != nil {
+		return nil, err
+	}
+	return withDriftCheck(c.kube, cl, ext), nil
+}
+
+// withDriftCheck returns an external client that checks the Buckets observed
+// by the supplied one for drift on the supplied MinIO server.
+func withDriftCheck(kube client.Client, c native.Clients, ext managed.ExternalClient) managed.ExternalClient {
+	return &driftExternal{ExternalClient: ext, kube: kube, liveState: liveBucketState(c, clients.DefaultDataUsageCache)}
+}
+
+// driftExternal records the live quota of a Bucket in its status, and
+// reports quota, versioning and object locking that was changed outside of
+// Crossplane in a way that its inner external client does not observe.
+type driftExternal struct {
+	managed.ExternalClient
+	kube      client.Client
+	liveState bucketStateFn
+
+	// drift is the drift found by Observe.
+	drift error
+}
+
+// Observe observes the Bucket with the inner external client, and then sets
+// status.atProvider.quota to the live quota and its usage if the quota is
+// managed. Only the managed parts of the state of the bucket are read. A
+// Bucket that the inner client observes as up to date but that has drifted
+// is reported as not up to date, so that Update returns the drift and the
+// Bucket is not Synced. Buckets that are not observed yet, are being deleted
+// or have an asynchronous operation in progress are not checked.
+func (e *driftExternal) Observe(ctx context.Context, mg xpresource.Managed) (managed.ExternalObservation, error) {
+	obs, err := e.ExternalClient.Observe(ctx, mg)
+	if err != nil || !obs.ResourceExists {
+		return obs, err
+	}
+	cr, ok := mg.(*v1alpha1.Bucket)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBucket)
+	}
+	name := meta.GetExternalName(cr)
+	if meta.WasDeleted(cr) || name == "" || cr.Status.AtProvider.ID == nil ||
+		cr.GetCondition(tjresource.TypeAsyncOperation).Reason == tjresource.ReasonOngoing {
+		return obs, nil
+	}
+
+	desired, err := e.desiredState(ctx, cr, name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	live, err := e.liveState(ctx, name, stateReads{
+		quota:         desired.quotaType != "",
+		versioning:    desired.versioning != "",
+		objectLocking: cr.Spec.ForProvider.ObjectLocking != nil,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	recordQuota(cr, live)
+	if !obs.ResourceUpToDate {
+		// Drift is checked again once the inner client has updated the
+		// bucket.
+		return obs, nil
+	}
+	tfQuota := uint64(ptr.Deref(cr.Status.AtProvider.QuotaBytes, 0))
+	if diff := diffBucketState(desired, live, tfQuota, ptr.Deref(cr.Status.AtProvider.ObjectLocking, false)); len(diff) > 0 {
+		e.drift = errors.Errorf(errDrifted, name, strings.Join(diff, "; "))
+		obs.ResourceUpToDate = false
+	}
+	return obs, nil
+}
+
+// Update returns the drift found by Observe, or updates the Bucket with the
+// inner external client if there is none.
+func (e *driftExternal) Update(ctx context.Context, mg xpresource.Managed) (managed.ExternalUpdate, error) {
+	if e.drift != nil {
+		return managed.ExternalUpdate{}, e.drift
+	}
+	return e.ExternalClient.Update(ctx, mg)
+}
+
+// desiredState returns the state of the named bucket that the Bucket and the
+// BucketVersioning that manages its versioning, if any, describe. Fields
+// that are not managed are left empty.
+func (e *driftExternal) desiredState(ctx context.Context, cr *v1alpha1.Bucket, name string) (bucketState, error) {
+	s := bucketState{}
+	p := cr.Spec.ForProvider
+	var err error
+	if s.quotaSize, s.quotaType, err = specQuota(p.Quota); err != nil {
+		return s, err
+	}
+	s.objectLocking = ptr.Deref(p.ObjectLocking, false)
+	if s.objectLocking {
+		// MinIO only allows object locking on versioned buckets.
+		s.versioning = versioningEnabled
+	}
+
+	l := &v1alpha1.BucketVersioningList{}
+	if err := e.kube.List(ctx, l, client.MatchingFields{versioningBucketField: name}); err != nil {
+		return s, errors.Wrap(err, errListBucketVersionings)
+	}
+	for i := range l.Items {
+		v := &l.Items[i]
+		if meta.WasDeleted(v) {
+			continue
+		}
+		for _, c := range v.Spec.ForProvider.VersioningConfiguration {
+			if status := ptr.Deref(c.Status, ""); status != "" {
+				s.versioning = status
+			}
+		}
+	}
+	return s, nil
+}
+
+// recordQuota sets status.atProvider.quota of the Bucket to the live quota
+// of its bucket, or removes it if the bucket has no quota or its quota is not
+// managed.
+func recordQuota(cr *v1alpha1.Bucket, live bucketState) {
+	if live.quotaSize == 0 {
+		cr.Status.AtProvider.Quota = nil
+		return
+	}
+	cr.Status.AtProvider.Quota = &v1alpha1.QuotaObservation{
+		Size:            ptr.To(formatBytes(live.quotaSize)),
+		Type:            ptr.To(live.quotaType),
+		UsagePercentage: ptr.To(math.Round(float64(live.usage)/float64(live.quotaSize)*10000) / 100),
+	}
+}
+
+// diffBucketState returns a description of every managed field of desired
+// that differs from live and that the inner external client did not observe
+// as different.
+func diffBucketState(desired, live bucketState, tfQuota uint64, tfObjectLocking bool) []string {
+	var diff []string
+	if desired.quotaType != "" && tfQuota == desired.quotaSize {
+		diff = append(diff, diffQuota(desired, live)...)
+	}
+	if desired.versioning != "" && live.versioning != desired.versioning {
+		diff = append(diff, fmt.Sprintf("versioning: spec %s, observed %s", desired.versioning, orNone(live.versioning)))
+	}
+	if tfObjectLocking == desired.objectLocking && live.objectLocking != desired.objectLocking {
+		diff = append(diff, fmt.Sprintf("objectLocking: spec %t, observed %t", desired.objectLocking, live.objectLocking))
+	}
+	return diff
+}
+
+// diffQuota returns a description of the size and type of the desired quota
+// that differ from the live one. A desired quota of 0 means that the bucket
+// should have no quota.
+func diffQuota(desired, live bucketState) []string {
+	var diff []string
+	if live.quotaSize != desired.quotaSize {
+		diff = append(diff, fmt.Sprintf("quota.size: spec %s, observed %s", formatBytes(desired.quotaSize), formatBytes(live.quotaSize)))
+	}
+	if live.quotaType != desired.quotaType {
+		diff = append(diff, fmt.Sprintf("quota.type: spec %s, observed %s", orNone(desired.quotaType), orNone(live.quotaType)))
+	}
+	return diff
+}
+
+// specQuota returns the size in bytes and the type of the supplied quota, or
+// 0 and an empty type if there is no quota.
+func specQuota(q *v1alpha1.QuotaParameters) (uint64, string, error) {
+	size, err := quotaSize(q)
+	if err != nil || size == 0 {
+		return 0, "", err
+	}
+	return size, ptr.Deref(q.Type, quotaTypeHard), nil
+}
+
+// liveQuota returns the size in bytes and the type of the quota of the named
+// bucket, or 0 and an empty type if it has no quota.
+func liveQuota(ctx context.Context, admin *madmin.AdminClient, name string) (uint64, string, error) {
+	q, err := admin.GetBucketQuota(ctx, name)
+	if err != nil {
+		return 0, "", errors.Wrap(err, errGetQuota)
+	}
+	size := q.Size
+	if size == 0 {
+		size = q.Quota //nolint:staticcheck // Set by servers that predate Size.
+	}
+	if size == 0 {
+		return 0, "", nil
+	}
+	if q.Type == "" {
+		// Quotas without a type are hard quotas.
+		return size, quotaTypeHard, nil
+	}
+	return size, string(q.Type), nil
+}
+
+// formatBytes returns a number of bytes as a binary resource quantity.
+func formatBytes(b uint64) string {
+	if b > math.MaxInt64 {
+		return fmt.Sprintf("%d", b)
+	}
+	return resource.NewQuantity(int64(b), resource.BinarySI).String()
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+// liveBucketState returns a bucketStateFn that reads the state of buckets on
+// the MinIO server of the supplied clients. The usage of buckets with a quota
+// is read from the supplied cache, since the admin API computes it for every
+// bucket of the server at once.
+func liveBucketState(c native.Clients, usage *clients.DataUsageCache) bucketStateFn {
+	return func(ctx context.Context, name string, r stateReads) (bucketState, error) {
+		s := bucketState{}
+		var err error
+		if r.quota {
+			if s.quotaSize, s.quotaType, err = liveQuota(ctx, c.Admin, name); err != nil {
+				return s, err
+			}
+		}
+		if s.quotaSize > 0 {
+			u, err := usage.DataUsage(ctx, c.Server, c.Admin.DataUsageInfo)
+			if err != nil {
+				return s, errors.Wrap(err, errGetUsage)
+			}
+			s.usage = u.BucketsUsage[name].Size
+		}
+		if r.versioning {
+			v, err := c.S3.GetBucketVersioning(ctx, name)
+			if err != nil {
+				return s, errors.Wrap(err, errGetVersioning)
+			}
+			s.versioning = v.Status
+		}
+		if r.objectLocking {
+			locking, _, _, _, err := c.S3.GetObjectLockConfig(ctx, name)
+			if err != nil && minio.ToErrorResponse(err).Code != codeNoObjectLock {
+				return s, errors.Wrap(err, errGetObjectLock)
+			}
+			s.objectLocking = locking == "Enabled"
+		}
+		return s, nil
+	}
+}
@@ -0,0 +1,290 @@
+package bucket
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	tjresource "github.com/crossplane/upjet/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1"
+)
+
+func newObservedBucket(external, quota string, objectLocking *bool) *v1alpha1.Bucket {
+	b := &v1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Name: external}}
+	meta.SetExternalName(b, external)
+	if quota != "" {
+		b.Spec.ForProvider.Quota = &v1alpha1.QuotaParameters{Size: ptr.To(quota)}
+		q, _ := quotaSize(b.Spec.ForProvider.Quota)
+		b.Status.AtProvider.QuotaBytes = ptr.To(float64(q))
+	}
+	b.Spec.ForProvider.ObjectLocking = objectLocking
+	b.Status.AtProvider.ID = ptr.To(external)
+	b.Status.AtProvider.ObjectLocking = objectLocking
+	return b
+}
+
+func TestDriftExternal(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatalf("cannot build scheme: %v", err)
+	}
+	kube := fake.NewClientBuilder().WithScheme(s).WithIndex(&v1alpha1.BucketVersioning{}, versioningBucketField, versioningBucket).WithObjects(
+		&v1alpha1.BucketVersioning{
+			ObjectMeta: metav1.ObjectMeta{Name: "versioned", Annotations: map[string]string{meta.AnnotationKeyExternalName: "versioned-bucket"}},
+			Spec: v1alpha1.BucketVersioningSpec{ForProvider: v1alpha1.BucketVersioningParameters{
+				VersioningConfiguration: []v1alpha1.VersioningConfigurationParameters{{
+					Status: ptr.To("Enabled"),
+				}},
+			}},
+		},
+		&v1alpha1.BucketVersioning{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Annotations: map[string]string{meta.AnnotationKeyExternalName: "other-bucket"}},
+			Spec: v1alpha1.BucketVersioningSpec{ForProvider: v1alpha1.BucketVersioningParameters{
+				VersioningConfiguration: []v1alpha1.VersioningConfigurationParameters{{
+					Status: ptr.To("Suspended"),
+				}},
+			}},
+		},
+	).Build()
+
+	tests := []struct {
+		name   string
+		bucket *v1alpha1.Bucket
+		// outdated is whether the inner external client observes the bucket
+		// as not up to date.
+		outdated bool
+		live     bucketState
+		// reads are the parts of the state of the bucket that are expected
+		// to be read.
+		reads  stateReads
+		quota  *v1alpha1.QuotaObservation
+		errMsg []string
+	}{
+		{
+			name:   "In sync",
+			bucket: newObservedBucket("data", "10Gi", nil),
+			live:   bucketState{quotaSize: 10 << 30, quotaType: "hard", usage: 1 << 30},
+			reads:  stateReads{quota: true},
+			quota:  &v1alpha1.QuotaObservation{Size: ptr.To("10Gi"), Type: ptr.To("hard"), UsagePercentage: ptr.To(10.0)},
+		},
+		{
+			name:   "Quota changed outside of Crossplane",
+			bucket: newObservedBucket("data", "10Gi", nil),
+			live:   bucketState{quotaSize: 5 << 30, quotaType: "fifo"},
+			reads:  stateReads{quota: true},
+			quota:  &v1alpha1.QuotaObservation{Size: ptr.To("5Gi"), Type: ptr.To("fifo"), UsagePercentage: ptr.To(0.0)},
+			errMsg: []string{`bucket "data" has drifted`, "quota.size: spec 10Gi, observed 5Gi", "quota.type: spec hard, observed fifo"},
+		},
+		{
+			name:   "Quota removed outside of Crossplane",
+			bucket: newObservedBucket("data", "1Mi", nil),
+			reads:  stateReads{quota: true},
+			errMsg: []string{"quota.size: spec 1Mi, observed 0", "quota.type: spec hard, observed none"},
+		},
+		{
+			name: "Quota drift observed by the inner client is left to it",
+			bucket: func() *v1alpha1.Bucket {
+				b := newObservedBucket("data", "10Gi", nil)
+				b.Status.AtProvider.QuotaBytes = ptr.To(float64(5 << 30))
+				return b
+			}(),
+			live:  bucketState{quotaSize: 5 << 30, quotaType: "hard"},
+			reads: stateReads{quota: true},
+			quota: &v1alpha1.QuotaObservation{Size: ptr.To("5Gi"), Type: ptr.To("hard"), UsagePercentage: ptr.To(0.0)},
+		},
+		{
+			name:     "Out of date bucket is updated by the inner client",
+			bucket:   newObservedBucket("data", "10Gi", nil),
+			outdated: true,
+			live:     bucketState{quotaSize: 5 << 30, quotaType: "fifo"},
+			reads:    stateReads{quota: true},
+			quota:    &v1alpha1.QuotaObservation{Size: ptr.To("5Gi"), Type: ptr.To("fifo"), UsagePercentage: ptr.To(0.0)},
+		},
+		{
+			name:   "Object locking disabled outside of Crossplane",
+			bucket: newObservedBucket("locked", "", ptr.To(true)),
+			live:   bucketState{versioning: "Suspended"},
+			reads:  stateReads{versioning: true, objectLocking: true},
+			errMsg: []string{"versioning: spec Enabled, observed Suspended", "objectLocking: spec true, observed false"},
+		},
+		{
+			name:   "Versioning of a BucketVersioning suspended outside of Crossplane",
+			bucket: newObservedBucket("versioned-bucket", "", nil),
+			live:   bucketState{versioning: "Suspended"},
+			reads:  stateReads{versioning: true},
+			errMsg: []string{"versioning: spec Enabled, observed Suspended"},
+		},
+		{
+			name:   "Unmanaged quota, versioning and object locking are not read",
+			bucket: newObservedBucket("data", "", nil),
+		},
+		{
+			name:   "Object locking disabled in the spec is checked",
+			bucket: newObservedBucket("data", "", ptr.To(false)),
+			live:   bucketState{objectLocking: true},
+			reads:  stateReads{objectLocking: true},
+			errMsg: []string{"objectLocking: spec false, observed true"},
+		},
+		{
+			name:   "Bucket not observed yet",
+			bucket: &v1alpha1.Bucket{Spec: v1alpha1.BucketSpec{ForProvider: v1alpha1.BucketParameters{Quota: &v1alpha1.QuotaParameters{Size: ptr.To("1")}}}},
+		},
+		{
+			name: "Asynchronous operation in progress",
+			bucket: func() *v1alpha1.Bucket {
+				b := newObservedBucket("data", "1Mi", nil)
+				b.SetConditions(tjresource.AsyncOperationOngoingCondition())
+				return b
+			}(),
+		},
+		{
+			name: "Deleted bucket is not checked",
+			bucket: func() *v1alpha1.Bucket {
+				b := newObservedBucket("data", "1", nil)
+				now := metav1.Now()
+				b.SetDeletionTimestamp(&now)
+				return b
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := false
+			e := &driftExternal{
+				ExternalClient: &managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ xpresource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: !tt.outdated}, nil
+					},
+					UpdateFn: func(_ context.Context, _ xpresource.Managed) (managed.ExternalUpdate, error) {
+						updated = true
+						return managed.ExternalUpdate{}, nil
+					},
+				},
+				kube: kube,
+				liveState: func(_ context.Context, _ string, r stateReads) (bucketState, error) {
+					if r != tt.reads {
+						t.Errorf("reads: want %+v, got %+v", tt.reads, r)
+					}
+					return tt.live, nil
+				},
+			}
+			obs, err := e.Observe(context.Background(), tt.bucket)
+			if err != nil {
+				t.Fatalf("Observe: unexpected error: %v", err)
+			}
+			if want := !tt.outdated && len(tt.errMsg) == 0; obs.ResourceUpToDate != want {
+				t.Errorf("up to date: want %t, got %t", want, obs.ResourceUpToDate)
+			}
+			if diff := cmp.Diff(tt.quota, tt.bucket.Status.AtProvider.Quota); diff != "" {
+				t.Errorf("status.atProvider.quota: -want, +got:\n%s", diff)
+			}
+
+			_, err = e.Update(context.Background(), tt.bucket)
+			if len(tt.errMsg) == 0 {
+				if err != nil {
+					t.Errorf("Update: unexpected error: %v", err)
+				}
+				if !updated {
+					t.Errorf("Update: the inner client was not called")
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Update: expected error but got none")
+			}
+			if updated {
+				t.Errorf("Update: the inner client was called for a drifted bucket")
+			}
+			for _, m := range tt.errMsg {
+				if !strings.Contains(err.Error(), m) {
+					t.Errorf("expected error to contain %q but got: %s", m, err)
+				}
+			}
+		})
+	}
+}
+
+// validations returns the CEL rules of the supplied CRD schema that apply to
+// obj and the fields that it sets.
+func validations(schema map[string]any, obj any) []string {
+	var rules []string
+	if vs, ok := schema["x-kubernetes-validations"].([]any); ok {
+		for _, v := range vs {
+			rules = append(rules, v.(map[string]any)["rule"].(string))
+		}
+	}
+	switch o := obj.(type) {
+	case map[string]any:
+		props, _ := schema["properties"].(map[string]any)
+		for k, v := range o {
+			if s, ok := props[k].(map[string]any); ok {
+				rules = append(rules, validations(s, v)...)
+			}
+		}
+	case []any:
+		if s, ok := schema["items"].(map[string]any); ok {
+			for _, v := range o {
+				rules = append(rules, validations(s, v)...)
+			}
+		}
+	}
+	return rules
+}
+
+func TestQuotaValidations(t *testing.T) {
+	raw, err := os.ReadFile(filepath.Join("..", "..", "..", "..", "package", "crds", "s3.minio.crossplane.io_buckets.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	crd := map[string]any{}
+	if err := yaml.Unmarshal(raw, &crd); err != nil {
+		t.Fatal(err)
+	}
+	versions := crd["spec"].(map[string]any)["versions"].([]any)
+	root := versions[0].(map[string]any)["schema"].(map[string]any)["openAPIV3Schema"].(map[string]any)["properties"].(map[string]any)
+
+	// A quota set outside of Crossplane is recorded in the status as it is.
+	b := newObservedBucket("data", "10Gi", nil)
+	recordQuota(b, bucketState{quotaSize: 5 << 30, quotaType: "fifo"})
+
+	tests := []struct {
+		name string
+		path string
+		obj  any
+		// validated is whether the object is expected to be subject to CEL
+		// validation rules.
+		validated bool
+	}{
+		{name: "Spec quota is validated", path: "spec", obj: b.Spec, validated: true},
+		{name: "Recorded non-hard live quota is not validated", path: "status", obj: b.Status},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j, err := json.Marshal(tt.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var obj map[string]any
+			if err := json.Unmarshal(j, &obj); err != nil {
+				t.Fatal(err)
+			}
+			rules := validations(root[tt.path].(map[string]any), obj)
+			if validated := len(rules) > 0; validated != tt.validated {
+				t.Errorf("validated: want %t, got %t (%v)", tt.validated, validated, rules)
+			}
+		})
+	}
+}
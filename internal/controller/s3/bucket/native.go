@@ -0,0 +1,215 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/upjet/pkg/config"
+	tjcontroller "github.com/crossplane/upjet/pkg/controller"
+	"github.com/crossplane/upjet/pkg/terraform"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1"
+	"github.com/markopolo123/provider-upjet-minio/internal/controller/native"
+)
+
+const (
+	errNotBucket     = "managed resource is not a Bucket"
+	errBucketExists  = "cannot check whether the bucket exists"
+	errGetQuota      = "cannot get bucket quota"
+	errSetQuota      = "cannot set bucket quota"
+	errParseQuota    = "cannot parse spec.forProvider.quota.size"
+	errGetUsage      = "cannot get bucket usage"
+	errGetObjectLock = "cannot get object lock configuration"
+	errMakeBucket    = "cannot create bucket"
+	errSetPolicy     = "cannot set bucket policy"
+	errRemoveBucket  = "cannot remove bucket"
+
+	// Error codes of the S3 API.
+	codeNoObjectLock = "ObjectLockConfigurationNotFoundError"
+	codeNoSuchBucket = "NoSuchBucket"
+
+	// defaultBucketPrefix is the prefix of generated bucket names.
+	defaultBucketPrefix = "terraform-"
+)
+
+// newConnector returns the connector of the Bucket controller, which
+// reconciles Buckets with the MinIO Go SDKs if the kind is reconciled
+// natively, and with Terraform otherwise. Either way Buckets are checked for
+// drift that the Terraform provider does not observe.
+func newConnector(kube client.Client, ws *terraform.WorkspaceStore, sf terraform.SetupFn, cfg *config.Resource, opts ...tjcontroller.Option) managed.ExternalConnecter {
+	tf := &driftConnector{ExternalConnecter: tjcontroller.NewConnector(kube, ws, sf, cfg, opts...), kube: kube}
+	return native.NewConnector(kube, v1alpha1.Bucket_GroupVersionKind, tf, func(c native.Clients) managed.ExternalClient {
+		return withDriftCheck(kube, c, &nativeExternal{s3: c.S3, admin: c.Admin, server: c.Server, region: c.Region})
+	})
+}
+
+// nativeExternal manages buckets the way the Terraform provider does, so
+// that the status of a Bucket is the same with either.
+type nativeExternal struct {
+	s3     *minio.Client
+	admin  *madmin.AdminClient
+	server string
+	region string
+}
+
+func (e *nativeExternal) Observe(ctx context.Context, mg xpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Bucket)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBucket)
+	}
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	exists, err := e.s3.BucketExists(ctx, name)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errBucketExists)
+	}
+	if !exists {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	live := bucketState{}
+	if live.quotaSize, live.quotaType, err = liveQuota(ctx, e.admin, name); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	locking, _, _, _, err := e.s3.GetObjectLockConfig(ctx, name)
+	if err != nil && minio.ToErrorResponse(err).Code != codeNoObjectLock {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetObjectLock)
+	}
+
+	p := cr.Spec.ForProvider
+	acl := ptr.Deref(p.ACL, defaultACL)
+	desired := bucketState{}
+	if desired.quotaSize, desired.quotaType, err = specQuota(p.Quota); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	// Like the Terraform provider, the acl is compared with the one that was
+	// last applied rather than with the bucket policy, which may be managed
+	// by a BucketPolicy. An acl that was never applied is applied. Like the
+	// Terraform provider, a quota that is not in the spec is removed.
+	upToDate := ptr.Deref(cr.Status.AtProvider.ACL, "") == acl && len(diffQuota(desired, live)) == 0
+
+	cr.Status.AtProvider = v1alpha1.BucketObservation{
+		ACL:              cr.Status.AtProvider.ACL,
+		Arn:              ptr.To("arn:aws:s3:::" + name),
+		Bucket:           ptr.To(name),
+		BucketDomainName: ptr.To(fmt.Sprintf("%s/minio/%s", e.server, name)),
+		BucketPrefix:     p.BucketPrefix,
+		ForceDestroy:     p.ForceDestroy,
+		ID:               ptr.To(name),
+		ObjectLocking:    ptr.To(locking == "Enabled"),
+	}
+	if live.quotaSize > 0 {
+		// The live quota and its usage are recorded in
+		// status.atProvider.quota by the drift check.
+		cr.Status.AtProvider.QuotaBytes = ptr.To(float64(live.quotaSize))
+	}
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *nativeExternal) Create(ctx context.Context, mg xpresource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Bucket)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBucket)
+	}
+	p := cr.Spec.ForProvider
+	name := ptr.Deref(p.Bucket, "")
+	if name == "" {
+		name = uniqueName(ptr.Deref(p.BucketPrefix, defaultBucketPrefix))
+	}
+	if err := e.s3.MakeBucket(ctx, name, minio.MakeBucketOptions{Region: e.region, ObjectLocking: ptr.Deref(p.ObjectLocking, false)}); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errMakeBucket)
+	}
+	meta.SetExternalName(cr, name)
+	return managed.ExternalCreation{}, e.apply(ctx, cr, name)
+}
+
+func (e *nativeExternal) Update(ctx context.Context, mg xpresource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Bucket)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBucket)
+	}
+	return managed.ExternalUpdate{}, e.apply(ctx, cr, meta.GetExternalName(cr))
+}
+
+// apply sets the acl, if it differs from the one that was last applied, and
+// the quota of the bucket. A quota that was observed but is no longer in the
+// spec is removed.
+func (e *nativeExternal) apply(ctx context.Context, cr *v1alpha1.Bucket, name string) error {
+	p := cr.Spec.ForProvider
+	acl := ptr.Deref(p.ACL, defaultACL)
+	if last := cr.Status.AtProvider.ACL; last == nil || *last != acl {
+		policy, err := aclPolicy(acl, name)
+		if err != nil {
+			return err
+		}
+		if err := e.s3.SetBucketPolicy(ctx, name, policy); err != nil {
+			return errors.Wrap(err, errSetPolicy)
+		}
+		cr.Status.AtProvider.ACL = ptr.To(acl)
+	}
+	size, typ, err := specQuota(p.Quota)
+	if err != nil {
+		return err
+	}
+	if size == 0 && cr.Status.AtProvider.QuotaBytes == nil {
+		return nil
+	}
+	// An empty quota removes the quota of the bucket.
+	q := &madmin.BucketQuota{Quota: size, Size: size, Type: madmin.QuotaType(typ)}
+	return errors.Wrap(e.admin.SetBucketQuota(ctx, name, q), errSetQuota)
+}
+
+// quotaSize returns the size of the supplied quota in bytes, or 0 if there is
+// no quota.
+func quotaSize(q *v1alpha1.QuotaParameters) (uint64, error) {
+	if q == nil || q.Size == nil {
+		return 0, nil
+	}
+	qty, err := resource.ParseQuantity(*q.Size)
+	if err != nil {
+		return 0, errors.Wrap(err, errParseQuota)
+	}
+	b, ok := qty.AsInt64()
+	if !ok || b < 0 {
+		return 0, errors.Errorf("%s: %s is not a whole, positive number of bytes", errParseQuota, *q.Size)
+	}
+	return uint64(b), nil
+}
+
+func (e *nativeExternal) Delete(ctx context.Context, mg xpresource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Bucket)
+	if !ok {
+		return errors.New(errNotBucket)
+	}
+	err := e.s3.RemoveBucketWithOptions(ctx, meta.GetExternalName(cr), minio.RemoveBucketOptions{ForceDelete: ptr.Deref(cr.Spec.ForProvider.ForceDestroy, false)})
+	if minio.ToErrorResponse(err).Code == codeNoSuchBucket {
+		return nil
+	}
+	return errors.Wrap(err, errRemoveBucket)
+}
+
+var nameCounter uint32
+
+// uniqueName returns a bucket name with the supplied prefix, in the format of
+// the names the Terraform provider generates.
+func uniqueName(prefix string) string {
+	return fmt.Sprintf("%s%s%08x", prefix, time.Now().UTC().Format("20060102150405"), atomic.AddUint32(&nameCounter, 1))
+}
@@ -0,0 +1,149 @@
+package bucket
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/upjet/pkg/resource"
+	"github.com/minio/minio-go/v7"
+	"k8s.io/utils/ptr"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1"
+	"github.com/markopolo123/provider-upjet-minio/internal/controller/native/nativetest"
+)
+
+func TestACLPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		acl     string
+		actions []string
+		errMsg  string
+	}{
+		{name: "private", acl: "private"},
+		{name: "public-read", acl: "public-read", actions: []string{"s3:GetBucketLocation", "s3:ListBucket", "s3:GetObject"}},
+		{name: "public", acl: "public", actions: []string{"s3:ListBucketMultipartUploads", "s3:PutObject", "s3:DeleteObject"}},
+		{name: "unknown", acl: "authenticated-read", errMsg: "unknown acl"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := aclPolicy(tc.acl, "data")
+			if tc.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.errMsg) {
+					t.Fatalf("expected error containing %q, got %v", tc.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.actions == nil && p != "" {
+				t.Fatalf("expected no policy, got %s", p)
+			}
+			for _, a := range tc.actions {
+				if !strings.Contains(p, `"`+a+`"`) {
+					t.Errorf("policy does not allow %s: %s", a, p)
+				}
+			}
+			if tc.actions != nil && !strings.Contains(p, `"arn:aws:s3:::data/*"`) {
+				t.Errorf("policy does not cover the objects of the bucket: %s", p)
+			}
+		})
+	}
+}
+
+// TestNativeEquivalence checks that buckets are observed with the same status
+// by the Terraform provider and with the MinIO Go SDKs.
+func TestNativeEquivalence(t *testing.T) {
+	c := nativetest.Clients(t)
+	e := &nativeExternal{s3: c.S3, admin: c.Admin, server: c.Server, region: c.Region}
+	nativetest.CompareWithTerraform(t, "minio_s3_bucket", e, func(name string) resource.Terraformed {
+		return newBucket(name)
+	})
+}
+
+func newBucket(name string) *v1alpha1.Bucket {
+	return &v1alpha1.Bucket{Spec: v1alpha1.BucketSpec{ForProvider: v1alpha1.BucketParameters{
+		ACL:          ptr.To("public-read"),
+		Bucket:       ptr.To(name),
+		ForceDestroy: ptr.To(true),
+		Quota:        &v1alpha1.QuotaParameters{Size: ptr.To("1Mi")},
+	}}}
+}
+
+// TestNativeLifecycle checks that a bucket is created, updated and deleted
+// with the MinIO Go SDKs.
+func TestNativeLifecycle(t *testing.T) {
+	c := nativetest.Clients(t)
+	ctx := context.Background()
+	e := &nativeExternal{s3: c.S3, admin: c.Admin, server: c.Server, region: c.Region}
+	name := nativetest.Name("native-bucket")
+	cr := newBucket(name)
+
+	if _, err := e.Create(ctx, cr); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if got := meta.GetExternalName(cr); got != name {
+		t.Fatalf("external name: want %q, got %q", name, got)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Fatalf("want an existing, up to date bucket, got %+v (%v)", obs, err)
+	}
+	if p, err := c.S3.GetBucketPolicy(ctx, name); err != nil || !strings.Contains(p, "s3:GetObject") {
+		t.Errorf("want a public-read policy, got %q (%v)", p, err)
+	}
+
+	// An acl that was not recorded as applied is applied again.
+	if err := c.S3.SetBucketPolicy(ctx, name, ""); err != nil {
+		t.Fatalf("SetBucketPolicy: %v", err)
+	}
+	cr.Status.AtProvider.ACL = nil
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Fatalf("want an acl that was not applied to be out of date, got %+v (%v)", obs, err)
+	}
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if p, err := c.S3.GetBucketPolicy(ctx, name); err != nil || !strings.Contains(p, "s3:GetObject") {
+		t.Errorf("want the public-read policy to be applied again, got %q (%v)", p, err)
+	}
+
+	cr.Spec.ForProvider.Quota.Size = ptr.To("2Mi")
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Fatalf("want a changed quota to be out of date, got %+v (%v)", obs, err)
+	}
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceUpToDate {
+		t.Fatalf("want an updated bucket to be up to date, got %+v (%v)", obs, err)
+	}
+
+	cr.Spec.ForProvider.Quota = nil
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Fatalf("want a removed quota to be out of date, got %+v (%v)", obs, err)
+	}
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if q, err := c.Admin.GetBucketQuota(ctx, name); err != nil || q.Size != 0 {
+		t.Errorf("want no quota, got %+v (%v)", q, err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceUpToDate || cr.Status.AtProvider.QuotaBytes != nil {
+		t.Fatalf("want a bucket without a quota to be up to date, got %+v (%v)", obs, err)
+	}
+
+	if _, err := c.S3.PutObject(ctx, name, "object", strings.NewReader("data"), 4, minio.PutObjectOptions{}); err != nil {
+		t.Fatalf("PutObject: %v", err)
+	}
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceExists {
+		t.Fatalf("want a deleted bucket, got %+v (%v)", obs, err)
+	}
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("Delete of a missing bucket: %v", err)
+	}
+}
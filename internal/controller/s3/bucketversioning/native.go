@@ -0,0 +1,160 @@
+package bucketversioning
+
+import (
+	"context"
+	"sort"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/upjet/pkg/config"
+	tjcontroller "github.com/crossplane/upjet/pkg/controller"
+	"github.com/crossplane/upjet/pkg/terraform"
+	"github.com/minio/minio-go/v7"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1"
+	"github.com/markopolo123/provider-upjet-minio/internal/controller/native"
+)
+
+const (
+	errNotBucketVersioning = "managed resource is not a BucketVersioning"
+	errNoConfiguration     = "versioningConfiguration is required"
+	errGetVersioning       = "cannot get bucket versioning"
+	errSetVersioning       = "cannot set bucket versioning"
+
+	codeNoSuchBucket = "NoSuchBucket"
+)
+
+// newConnector returns the connector of the BucketVersioning controller, which
+// reconciles BucketVersionings with the MinIO Go SDKs if the kind is reconciled
+// natively, and with Terraform otherwise.
+func newConnector(kube client.Client, ws *terraform.WorkspaceStore, sf terraform.SetupFn, cfg *config.Resource, opts ...tjcontroller.Option) managed.ExternalConnecter {
+	return native.NewConnector(kube, v1alpha1.BucketVersioning_GroupVersionKind, tjcontroller.NewConnector(kube, ws, sf, cfg, opts...), func(c native.Clients) managed.ExternalClient {
+		return &nativeExternal{s3: c.S3}
+	})
+}
+
+// nativeExternal manages the versioning configuration of the bucket named by
+// the external name. Like the Terraform provider, deleting suspends
+// versioning.
+type nativeExternal struct {
+	s3 *minio.Client
+}
+
+func (e *nativeExternal) Observe(ctx context.Context, mg xpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BucketVersioning)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBucketVersioning)
+	}
+	name := meta.GetExternalName(cr)
+	cfg, err := e.s3.GetBucketVersioning(ctx, name)
+	if minio.ToErrorResponse(err).Code == codeNoSuchBucket {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetVersioning)
+	}
+	// Versioning cannot be turned off once it was enabled, so a suspended
+	// configuration counts as deleted while the resource is deleted.
+	if cfg.Status == "" || (cfg.Status == minio.Suspended && meta.WasDeleted(cr)) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed := v1alpha1.VersioningConfigurationObservation{
+		ExcludeFolders:   ptr.To(cfg.ExcludeFolders),
+		ExcludedPrefixes: make([]*string, 0, len(cfg.ExcludedPrefixes)),
+		Status:           ptr.To(cfg.Status),
+	}
+	for _, p := range cfg.ExcludedPrefixes {
+		observed.ExcludedPrefixes = append(observed.ExcludedPrefixes, ptr.To(p.Prefix))
+	}
+	cr.Status.AtProvider = v1alpha1.BucketVersioningObservation{
+		ID:                      ptr.To(name),
+		VersioningConfiguration: []v1alpha1.VersioningConfigurationObservation{observed},
+	}
+	cr.SetConditions(xpv1.Available())
+
+	desired, err := configuration(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: equal(desired, cfg),
+	}, nil
+}
+
+func (e *nativeExternal) Create(ctx context.Context, mg xpresource.Managed) (managed.ExternalCreation, error) {
+	_, err := e.Update(ctx, mg)
+	return managed.ExternalCreation{}, err
+}
+
+func (e *nativeExternal) Update(ctx context.Context, mg xpresource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BucketVersioning)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBucketVersioning)
+	}
+	cfg, err := configuration(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(e.s3.SetBucketVersioning(ctx, meta.GetExternalName(cr), cfg), errSetVersioning)
+}
+
+func (e *nativeExternal) Delete(ctx context.Context, mg xpresource.Managed) error {
+	cr, ok := mg.(*v1alpha1.BucketVersioning)
+	if !ok {
+		return errors.New(errNotBucketVersioning)
+	}
+	err := e.s3.SuspendVersioning(ctx, meta.GetExternalName(cr))
+	if minio.ToErrorResponse(err).Code == codeNoSuchBucket {
+		return nil
+	}
+	return errors.Wrap(err, errSetVersioning)
+}
+
+// configuration returns the versioning configuration in the spec of cr.
+func configuration(cr *v1alpha1.BucketVersioning) (minio.BucketVersioningConfiguration, error) {
+	if len(cr.Spec.ForProvider.VersioningConfiguration) == 0 {
+		return minio.BucketVersioningConfiguration{}, errors.New(errNoConfiguration)
+	}
+	p := cr.Spec.ForProvider.VersioningConfiguration[0]
+	cfg := minio.BucketVersioningConfiguration{
+		Status:         ptr.Deref(p.Status, ""),
+		ExcludeFolders: ptr.Deref(p.ExcludeFolders, false),
+	}
+	for _, prefix := range p.ExcludedPrefixes {
+		if prefix != nil {
+			cfg.ExcludedPrefixes = append(cfg.ExcludedPrefixes, minio.ExcludedPrefix{Prefix: *prefix})
+		}
+	}
+	return cfg, nil
+}
+
+// equal returns whether two versioning configurations are the same,
+// regardless of the order of their excluded prefixes.
+func equal(a, b minio.BucketVersioningConfiguration) bool {
+	if a.Status != b.Status || a.ExcludeFolders != b.ExcludeFolders || len(a.ExcludedPrefixes) != len(b.ExcludedPrefixes) {
+		return false
+	}
+	pa, pb := prefixes(a), prefixes(b)
+	for i := range pa {
+		if pa[i] != pb[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func prefixes(c minio.BucketVersioningConfiguration) []string {
+	p := make([]string, 0, len(c.ExcludedPrefixes))
+	for _, e := range c.ExcludedPrefixes {
+		p = append(p, e.Prefix)
+	}
+	sort.Strings(p)
+	return p
+}
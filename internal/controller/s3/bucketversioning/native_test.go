@@ -0,0 +1,121 @@
+package bucketversioning
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/upjet/pkg/resource"
+	"github.com/minio/minio-go/v7"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1"
+	"github.com/markopolo123/provider-upjet-minio/internal/controller/native"
+	"github.com/markopolo123/provider-upjet-minio/internal/controller/native/nativetest"
+)
+
+func TestEqual(t *testing.T) {
+	cfg := func(status string, prefixes ...string) minio.BucketVersioningConfiguration {
+		c := minio.BucketVersioningConfiguration{Status: status}
+		for _, p := range prefixes {
+			c.ExcludedPrefixes = append(c.ExcludedPrefixes, minio.ExcludedPrefix{Prefix: p})
+		}
+		return c
+	}
+	tests := []struct {
+		name string
+		a, b minio.BucketVersioningConfiguration
+		want bool
+	}{
+		{name: "same", a: cfg("Enabled", "a/", "b/"), b: cfg("Enabled", "a/", "b/"), want: true},
+		{name: "reordered prefixes", a: cfg("Enabled", "a/", "b/"), b: cfg("Enabled", "b/", "a/"), want: true},
+		{name: "different status", a: cfg("Enabled"), b: cfg("Suspended")},
+		{name: "different prefixes", a: cfg("Enabled", "a/"), b: cfg("Enabled", "b/")},
+		{name: "missing prefix", a: cfg("Enabled", "a/"), b: cfg("Enabled")},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := equal(tc.a, tc.b); got != tc.want {
+				t.Errorf("want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+// TestNativeEquivalence checks that bucket versioning is observed with the
+// same status by the Terraform provider and with the MinIO Go SDKs.
+func TestNativeEquivalence(t *testing.T) {
+	c := nativetest.Clients(t)
+	ctx := context.Background()
+	nativetest.CompareWithTerraform(t, "minio_s3_bucket_versioning", &nativeExternal{s3: c.S3}, func(name string) resource.Terraformed {
+		if exists, err := c.S3.BucketExists(ctx, name); err != nil || !exists {
+			makeBucket(t, c, name)
+		}
+		cr := newVersioning()
+		meta.SetExternalName(cr, name)
+		return cr
+	})
+}
+
+func makeBucket(t *testing.T, c native.Clients, name string) {
+	t.Helper()
+	ctx := context.Background()
+	if err := c.S3.MakeBucket(ctx, name, minio.MakeBucketOptions{Region: c.Region}); err != nil {
+		t.Fatalf("MakeBucket: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = c.S3.RemoveBucketWithOptions(ctx, name, minio.RemoveBucketOptions{ForceDelete: true})
+	})
+}
+
+func newVersioning() *v1alpha1.BucketVersioning {
+	return &v1alpha1.BucketVersioning{Spec: v1alpha1.BucketVersioningSpec{ForProvider: v1alpha1.BucketVersioningParameters{
+		VersioningConfiguration: []v1alpha1.VersioningConfigurationParameters{{
+			Status:           ptr.To("Enabled"),
+			ExcludeFolders:   ptr.To(true),
+			ExcludedPrefixes: []*string{ptr.To("tmp/"), ptr.To("cache/")},
+		}},
+	}}}
+}
+
+// TestNativeLifecycle checks that bucket versioning is created, updated and
+// deleted with the MinIO Go SDKs.
+func TestNativeLifecycle(t *testing.T) {
+	c := nativetest.Clients(t)
+	ctx := context.Background()
+	e := &nativeExternal{s3: c.S3}
+	name := nativetest.Name("native-versioning")
+	makeBucket(t, c, name)
+	cr := newVersioning()
+	meta.SetExternalName(cr, name)
+
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceExists {
+		t.Fatalf("want no versioning before Create, got %+v (%v)", obs, err)
+	}
+	if _, err := e.Create(ctx, cr); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Fatalf("want existing, up to date versioning, got %+v (%v)", obs, err)
+	}
+
+	cr.Spec.ForProvider.VersioningConfiguration[0].ExcludedPrefixes = []*string{ptr.To("tmp/")}
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Fatalf("want changed prefixes to be out of date, got %+v (%v)", obs, err)
+	}
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceUpToDate {
+		t.Fatalf("want updated versioning to be up to date, got %+v (%v)", obs, err)
+	}
+
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	cr.SetDeletionTimestamp(ptr.To(metav1.Now()))
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceExists {
+		t.Fatalf("want suspended versioning to be deleted, got %+v (%v)", obs, err)
+	}
+}
@@ -0,0 +1,153 @@
+package objectdata
+
+import (
+	"context"
+	"io"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	tjcontroller "github.com/crossplane/upjet/pkg/controller"
+	"github.com/minio/minio-go/v7"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1"
+	"github.com/markopolo123/provider-upjet-minio/internal/clients"
+	"github.com/markopolo123/provider-upjet-minio/internal/features"
+)
+
+const (
+	errNotObjectData  = "managed resource is not an ObjectData"
+	errNoBucketName   = "bucketName is not set"
+	errGetObject      = "cannot get object"
+	errObjectTooLarge = "object is larger than %d bytes and cannot be read into status"
+
+	// maxContentSize is the largest object that is read into status. Objects
+	// in status count against the etcd object size limit.
+	maxContentSize = 256 * 1024
+)
+
+// Setup adds a controller that reconciles ObjectData managed resources.
+func Setup(mgr ctrl.Manager, o tjcontroller.Options) error {
+	name := managed.ControllerName(v1alpha1.ObjectData_GroupVersionKind.String())
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: clients.NewMinioClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithTimeout(1 * time.Minute),
+		managed.WithPollInterval(o.PollInterval),
+	}
+	if o.Features.Enabled(features.EnableBetaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	r := managed.NewReconciler(mgr, xpresource.ManagedKind(v1alpha1.ObjectData_GroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(xpresource.DesiredStateChanged()).
+		For(&v1alpha1.ObjectData{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// connector produces an external client for the MinIO server of the
+// ProviderConfig referenced by an ObjectData.
+type connector struct {
+	kube        client.Client
+	newClientFn func(creds map[string]string) (*minio.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg xpresource.Managed) (managed.ExternalClient, error) {
+	creds, err := clients.ConnectCredentials(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	mc, err := c.newClientFn(creds)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: mc}, nil
+}
+
+// external reads an object on every observation. The object is never
+// written, so creating, updating and deleting are no-ops.
+type external struct {
+	client *minio.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg xpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ObjectData)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotObjectData)
+	}
+	if meta.WasDeleted(cr) {
+		// Report the object as gone so that the finalizer is removed.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	p := cr.Spec.ForProvider
+	if p.BucketName == nil || *p.BucketName == "" {
+		return managed.ExternalObservation{}, errors.New(errNoBucketName)
+	}
+
+	opts := minio.GetObjectOptions{}
+	if p.VersionID != nil {
+		opts.VersionID = *p.VersionID
+	}
+	obj, err := e.client.GetObject(ctx, *p.BucketName, *p.ObjectName, opts)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetObject)
+	}
+	defer obj.Close() //nolint:errcheck // Nothing is written.
+
+	info, err := obj.Stat()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetObject)
+	}
+	if info.Size > maxContentSize {
+		return managed.ExternalObservation{}, errors.Errorf(errObjectTooLarge, maxContentSize)
+	}
+	content, err := io.ReadAll(obj)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetObject)
+	}
+
+	cr.Status.AtProvider = v1alpha1.ObjectDataObservation{
+		Content:      ptr.To(string(content)),
+		ContentType:  ptr.To(info.ContentType),
+		ETag:         ptr.To(info.ETag),
+		LastModified: &metav1.Time{Time: info.LastModified},
+		Size:         &info.Size,
+	}
+	if info.VersionID != "" {
+		cr.Status.AtProvider.VersionID = ptr.To(info.VersionID)
+	}
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(_ context.Context, _ xpresource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(_ context.Context, _ xpresource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(_ context.Context, _ xpresource.Managed) error {
+	return nil
+}
@@ -0,0 +1,121 @@
+package objectdata
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/markopolo123/provider-upjet-minio/apis/s3/v1alpha1"
+	"github.com/markopolo123/provider-upjet-minio/internal/clients"
+)
+
+// newServer returns a server that serves the supplied objects by
+// "bucket/object" path.
+func newServer(objects map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, ok := objects[strings.TrimPrefix(r.URL.Path, "/")]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		w.Header().Set("ETag", `"abc123"`)
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		_, _ = w.Write([]byte(content))
+	}))
+}
+
+func TestObserve(t *testing.T) {
+	srv := newServer(map[string]string{
+		"config/app.json": `{"key": "value"}`,
+		"config/big.bin":  strings.Repeat("x", maxContentSize+1),
+	})
+	defer srv.Close()
+
+	mc, err := clients.NewMinioClient(map[string]string{
+		"minio_server":   strings.TrimPrefix(srv.URL, "http://"),
+		"minio_user":     "user",
+		"minio_password": "password",
+		"minio_region":   "us-east-1",
+	})
+	if err != nil {
+		t.Fatalf("cannot create client: %v", err)
+	}
+	e := &external{client: mc}
+
+	tests := []struct {
+		name    string
+		bucket  *string
+		object  string
+		content string
+		errMsg  string
+	}{
+		{
+			name:    "Object is read into status",
+			bucket:  ptr.To("config"),
+			object:  "app.json",
+			content: `{"key": "value"}`,
+		},
+		{
+			name:   "Missing object",
+			bucket: ptr.To("config"),
+			object: "missing.json",
+			errMsg: "The specified key does not exist",
+		},
+		{
+			name:   "Object too large",
+			bucket: ptr.To("config"),
+			object: "big.bin",
+			errMsg: "object is larger than",
+		},
+		{
+			name:   "Bucket not resolved yet",
+			object: "app.json",
+			errMsg: errNoBucketName,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.ObjectData{Spec: v1alpha1.ObjectDataSpec{ForProvider: v1alpha1.ObjectDataParameters{
+				BucketName: tt.bucket,
+				ObjectName: ptr.To(tt.object),
+			}}}
+			obs, err := e.Observe(context.Background(), cr)
+
+			if tt.errMsg != "" {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("expected error to contain '%s' but got: %s", tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !obs.ResourceExists || !obs.ResourceUpToDate {
+				t.Errorf("expected the object to exist and be up to date, got %+v", obs)
+			}
+			at := cr.Status.AtProvider
+			if got := ptr.Deref(at.Content, ""); got != tt.content {
+				t.Errorf("content = %q, want %q", got, tt.content)
+			}
+			if got := ptr.Deref(at.ContentType, ""); got != "application/json" {
+				t.Errorf("contentType = %q, want %q", got, "application/json")
+			}
+			if got := ptr.Deref(at.ETag, ""); got != "abc123" {
+				t.Errorf("etag = %q, want %q", got, "abc123")
+			}
+		})
+	}
+}
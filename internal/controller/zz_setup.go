@@ -9,34 +9,64 @@ import (
 
 	"github.com/crossplane/upjet/pkg/controller"
 
+	accesskey "github.com/markopolo123/provider-upjet-minio/internal/controller/iam/accesskey"
 	group "github.com/markopolo123/provider-upjet-minio/internal/controller/iam/group"
+	groupmembership "github.com/markopolo123/provider-upjet-minio/internal/controller/iam/groupmembership"
+	grouppolicy "github.com/markopolo123/provider-upjet-minio/internal/controller/iam/grouppolicy"
+	grouppolicyattachment "github.com/markopolo123/provider-upjet-minio/internal/controller/iam/grouppolicyattachment"
+	groupuserattachment "github.com/markopolo123/provider-upjet-minio/internal/controller/iam/groupuserattachment"
+	ldapgrouppolicyattachment "github.com/markopolo123/provider-upjet-minio/internal/controller/iam/ldapgrouppolicyattachment"
+	ldapuserpolicyattachment "github.com/markopolo123/provider-upjet-minio/internal/controller/iam/ldapuserpolicyattachment"
 	policy "github.com/markopolo123/provider-upjet-minio/internal/controller/iam/policy"
+	policydocument "github.com/markopolo123/provider-upjet-minio/internal/controller/iam/policydocument"
 	serviceaccount "github.com/markopolo123/provider-upjet-minio/internal/controller/iam/serviceaccount"
 	user "github.com/markopolo123/provider-upjet-minio/internal/controller/iam/user"
+	userpolicyattachment "github.com/markopolo123/provider-upjet-minio/internal/controller/iam/userpolicyattachment"
+	lifecyclepolicy "github.com/markopolo123/provider-upjet-minio/internal/controller/ilm/lifecyclepolicy"
+	tier "github.com/markopolo123/provider-upjet-minio/internal/controller/ilm/tier"
 	key "github.com/markopolo123/provider-upjet-minio/internal/controller/kms/key"
 	providerconfig "github.com/markopolo123/provider-upjet-minio/internal/controller/providerconfig"
 	bucket "github.com/markopolo123/provider-upjet-minio/internal/controller/s3/bucket"
 	bucketnotification "github.com/markopolo123/provider-upjet-minio/internal/controller/s3/bucketnotification"
 	bucketpolicy "github.com/markopolo123/provider-upjet-minio/internal/controller/s3/bucketpolicy"
+	bucketreplication "github.com/markopolo123/provider-upjet-minio/internal/controller/s3/bucketreplication"
+	bucketretention "github.com/markopolo123/provider-upjet-minio/internal/controller/s3/bucketretention"
+	bucketserversideencryption "github.com/markopolo123/provider-upjet-minio/internal/controller/s3/bucketserversideencryption"
 	bucketversioning "github.com/markopolo123/provider-upjet-minio/internal/controller/s3/bucketversioning"
 	object "github.com/markopolo123/provider-upjet-minio/internal/controller/s3/object"
+	objectdata "github.com/markopolo123/provider-upjet-minio/internal/controller/s3/objectdata"
 )
 
 // Setup creates all controllers with the supplied logger and adds them to
 // the supplied manager.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
+		accesskey.Setup,
 		group.Setup,
+		groupmembership.Setup,
+		grouppolicy.Setup,
+		grouppolicyattachment.Setup,
+		groupuserattachment.Setup,
+		ldapgrouppolicyattachment.Setup,
+		ldapuserpolicyattachment.Setup,
 		policy.Setup,
+		policydocument.Setup,
 		serviceaccount.Setup,
 		user.Setup,
+		userpolicyattachment.Setup,
+		lifecyclepolicy.Setup,
+		tier.Setup,
 		key.Setup,
 		providerconfig.Setup,
 		bucket.Setup,
 		bucketnotification.Setup,
 		bucketpolicy.Setup,
+		bucketreplication.Setup,
+		bucketretention.Setup,
+		bucketserversideencryption.Setup,
 		bucketversioning.Setup,
 		object.Setup,
+		objectdata.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err